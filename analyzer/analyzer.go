@@ -15,44 +15,117 @@ import (
 )
 
 const (
-	EqualityComparisonCheckName       = "equality-comparison"
-	GotBeforeWantCheck                = "got-before-want"
-	IdentifyTheFunctionCHeck          = "identify-function"
-	TableDrivenFormatCheckTypeName    = "table-driven-format.type"
-	TableDrivenFormatCheckInlinedName = "table-driven-format.inlined"
+	AssertionLibraryCheckName            = "assertion-library"
+	AssertionLibraryCheckPackagesName    = "assertion-library.packages"
+	CompareFullStructuresCheckName       = "compare-full-structures"
+	CompareStableResultsCheckName        = "compare-stable-results"
+	CompareStableResultsCheckSerializers = "compare-stable-results.serializers"
+	DiffDirectionCheckName               = "diff-direction"
+	EqualityComparisonCheckName          = "equality-comparison"
+	ErrorSemanticsCheckName              = "error-semantics"
+	GotBeforeWantCheck                   = "got-before-want"
+	IdentifyTheFunctionCHeck             = "identify-function"
+	IdentifyTheInputCheckName            = "identify-input"
+	KeepGoingCheckName                   = "keep-going"
+	MarkTestHelpersCheckName             = "mark-test-helpers"
+	PrintDiffsCheckName                  = "print-diffs"
+	PrintDiffsCheckKindsName             = "print-diffs.kinds"
+	PrintDiffsCheckMinStructFields       = "print-diffs.min-struct-fields"
+	SubtestNamesCheckName                = "subtest-names"
+	TableDrivenFormatCheckTypeName       = "table-driven-format.type"
+	TableDrivenFormatCheckInlinedName    = "table-driven-format.inlined"
+	UseSubtestsCheckName                 = "use-subtests"
 )
 
 func New() *analysis.Analyzer {
 	l := testcommentslint{}
 
 	a := &analysis.Analyzer{
-		Name:     "testcommentslint",
-		Doc:      "checks test follow standards",
-		URL:      "https://github.com/manuelarte/testcommentslint",
-		Run:      l.run,
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Name:      "testcommentslint",
+		Doc:       "checks test follow standards",
+		URL:       "https://github.com/manuelarte/testcommentslint",
+		Run:       l.run,
+		Requires:  []*analysis.Analyzer{inspect.Analyzer},
+		FactTypes: []analysis.Fact{new(model.HelperFact)},
 	}
 
+	a.Flags.BoolVar(&l.assertionLibrary.enabled, AssertionLibraryCheckName, false,
+		"Check that the tests don't use assertion libraries like testify/assert.")
+	a.Flags.StringVar(&l.assertionLibrary.packages, AssertionLibraryCheckPackagesName,
+		strings.Join(checks.DefaultAssertionLibraries, ","),
+		"Comma separated packages of assertion libraries to report, they replace the default ones.")
+	a.Flags.BoolVar(&l.compareFullStructures, CompareFullStructuresCheckName, false,
+		"Check that the structs are compared in a single comparison instead of field by field.")
+	a.Flags.BoolVar(&l.compareStableResults.enabled, CompareStableResultsCheckName, false,
+		"Check that the tests don't compare the output of unstable serializers like json.Marshal.")
+	a.Flags.StringVar(&l.compareStableResults.serializers, CompareStableResultsCheckSerializers,
+		strings.Join(checks.DefaultUnstableSerializers, ","),
+		"Comma separated unstable serializers, as <package path>.<function>, whose output should not be compared, "+
+			"they replace the default ones.")
+	a.Flags.BoolVar(&l.diffDirection, DiffDirectionCheckName, false,
+		"Check that the cmp.Diff failure messages print the diff with a legend that matches the arguments order.")
 	a.Flags.BoolVar(&l.equalityComparison, EqualityComparisonCheckName, true,
 		"Checks reflect.DeepEqual can be replaced by newer cmp.Equal.")
+	a.Flags.BoolVar(&l.errorSemantics, ErrorSemanticsCheckName, false,
+		"Check that the errors are not compared by their messages, or with reflect.DeepEqual or cmp.Equal.")
 	a.Flags.BoolVar(&l.gotBeforeWant, GotBeforeWantCheck, true,
 		"Check that output the actual value that the function returned before printing the value that was expected.")
 	a.Flags.BoolVar(&l.identifyFunction, IdentifyTheFunctionCHeck, true,
 		"Check that the failure messages in t.Errorf contains the function name.")
+	a.Flags.BoolVar(&l.identifyInput, IdentifyTheInputCheckName, false,
+		"Check that the failure messages in t.Errorf contains the inputs of the function.")
+	a.Flags.BoolVar(&l.keepGoing, KeepGoingCheckName, false,
+		"Check that t.Error is used instead of t.Fatal when the test can keep going after a failed comparison.")
+	a.Flags.BoolVar(&l.markTestHelpers, MarkTestHelpersCheckName, false,
+		"Check that the test helpers that report failures call t.Helper().")
+	a.Flags.BoolVar(&l.printDiffs.enabled, PrintDiffsCheckName, false,
+		"Check that the composite values compared are printed as a diff instead of with %v.")
+	a.Flags.StringVar(&l.printDiffs.kinds, PrintDiffsCheckKindsName, "struct,map,slice,array",
+		"Comma separated kinds of composite values that should be printed as a diff.")
+	a.Flags.IntVar(&l.printDiffs.minStructFields, PrintDiffsCheckMinStructFields, 2,
+		"Minimum number of fields of a struct to be printed as a diff.")
+	a.Flags.BoolVar(&l.subtestNames, SubtestNamesCheckName, false,
+		"Check that the subtest names of the table-driven tests are human-readable, unique and slash-free.")
 	a.Flags.StringVar(&l.tableDrivenFormat.formatType, TableDrivenFormatCheckTypeName, "",
 		"Check that the table-driven tests are either Map or Slice.")
 	a.Flags.BoolVar(&l.tableDrivenFormat.inlined, TableDrivenFormatCheckInlinedName, false,
 		"Check that the table-driven tests are either inline or declared before.")
+	a.Flags.BoolVar(&l.useSubtests, UseSubtestsCheckName, false,
+		"Check that the test cases of the table-driven tests are run in t.Run subtests.")
 
 	return a
 }
 
 type (
 	testcommentslint struct {
-		equalityComparison bool
-		gotBeforeWant      bool
-		identifyFunction   bool
-		tableDrivenFormat  tableDrivenFormat
+		assertionLibrary      assertionLibrary
+		compareFullStructures bool
+		compareStableResults  compareStableResults
+		diffDirection         bool
+		equalityComparison    bool
+		errorSemantics        bool
+		gotBeforeWant         bool
+		identifyFunction      bool
+		identifyInput         bool
+		keepGoing             bool
+		markTestHelpers       bool
+		printDiffs            printDiffs
+		subtestNames          bool
+		tableDrivenFormat     tableDrivenFormat
+		useSubtests           bool
+	}
+	assertionLibrary struct {
+		enabled  bool
+		packages string
+	}
+	compareStableResults struct {
+		enabled     bool
+		serializers string
+	}
+	printDiffs struct {
+		enabled         bool
+		kinds           string
+		minStructFields int
 	}
 	tableDrivenFormat struct {
 		formatType string
@@ -71,6 +144,42 @@ func (t tableDrivenFormat) getTableDrivenFormatPredicate() checks.TableDrivenFor
 	return pred
 }
 
+func (a assertionLibrary) getPackages() []string {
+	packages := make([]string, 0)
+
+	for pkg := range strings.SplitSeq(a.packages, ",") {
+		if pkg = strings.TrimSpace(pkg); pkg != "" {
+			packages = append(packages, pkg)
+		}
+	}
+
+	return packages
+}
+
+func (c compareStableResults) getSerializers() []string {
+	serializers := make([]string, 0)
+
+	for serializer := range strings.SplitSeq(c.serializers, ",") {
+		if serializer = strings.TrimSpace(serializer); serializer != "" {
+			serializers = append(serializers, serializer)
+		}
+	}
+
+	return serializers
+}
+
+func (p printDiffs) getKinds() []checks.PrintDiffsKind {
+	kinds := make([]checks.PrintDiffsKind, 0)
+
+	for kind := range strings.SplitSeq(p.kinds, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			kinds = append(kinds, checks.PrintDiffsKind(kind))
+		}
+	}
+
+	return kinds
+}
+
 //nolint:gocognit // refactor later
 func (l *testcommentslint) run(pass *analysis.Pass) (any, error) {
 	insp, found := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
@@ -80,7 +189,6 @@ func (l *testcommentslint) run(pass *analysis.Pass) (any, error) {
 	}
 
 	nodeFilter := []ast.Node{
-		(*ast.ImportSpec)(nil),
 		(*ast.FuncDecl)(nil),
 	}
 
@@ -89,43 +197,99 @@ func (l *testcommentslint) run(pass *analysis.Pass) (any, error) {
 		return nil, fmt.Errorf("error creating table driven format checker: %w", err)
 	}
 
-	var importGroup model.ImportGroup
+	pdCheck, err := checks.NewPrintDiffs(l.printDiffs.getKinds(), l.printDiffs.minStructFields)
+	if err != nil {
+		return nil, fmt.Errorf("error creating print diffs checker: %w", err)
+	}
+
+	csrCheck, err := checks.NewCompareStableResults(l.compareStableResults.getSerializers())
+	if err != nil {
+		return nil, fmt.Errorf("error creating compare stable results checker: %w", err)
+	}
+
+	// the helpers are looked for in all the files, so the packages that import them, e.g. testutil packages,
+	// can understand their calls.
+	exportHelperFacts(pass)
+
+	// the tables of the table-driven tests can be declared in package-level variables or functions of any file.
+	decls := model.NewPackageDecls(pass.TypesInfo, pass.Files)
+
+	// the shared tables are used by several tests, so their subtest names are checked once.
+	snCheck := checks.NewSubtestNames()
 
 	insp.Preorder(nodeFilter, func(n ast.Node) {
 		// Only process _test.go files
 		if !strings.HasSuffix(pass.Fset.File(n.Pos()).Name(), "_test.go") {
-			importGroup = model.ImportGroup{}
-
 			return
 		}
 
 		switch node := n.(type) {
-		case *ast.ImportSpec:
-			if model.IsReflectImport(node) {
-				importGroup.Reflect = node
-			}
-
-			if model.IsGoCmpImport(node) {
-				importGroup.GoCmp = node
-			}
 		case *ast.FuncDecl:
-			testFunc, ok := model.NewTestFunction(importGroup, node)
-			if !ok {
-				return
+			if l.markTestHelpers {
+				for _, testHelper := range model.NewTestHelpers(pass.TypesInfo, node) {
+					checks.NewMarkTestHelpers().Check(pass, testHelper)
+				}
 			}
 
-			tbfCheck.Check(pass, testFunc)
+			// a fuzz target contains its fuzz callback, that is checked as a test function on its own.
+			for _, testFunc := range model.NewTestFunctions(pass.TypesInfo, decls, node) {
+				tbfCheck.Check(pass, testFunc)
 
-			if l.equalityComparison {
-				checks.NewEqualityComparison().Check(pass, testFunc)
-			}
+				if l.assertionLibrary.enabled {
+					checks.NewAssertionLibrary(l.assertionLibrary.getPackages()).Check(pass, testFunc)
+				}
 
-			if l.gotBeforeWant {
-				checks.NewGotBeforeWant().Check(pass, testFunc)
-			}
+				if l.compareFullStructures {
+					checks.NewCompareFullStructures().Check(pass, testFunc)
+				}
+
+				if l.compareStableResults.enabled {
+					csrCheck.Check(pass, testFunc)
+				}
+
+				if l.diffDirection {
+					checks.NewDiffDirection().Check(pass, testFunc)
+				}
+
+				if l.equalityComparison {
+					checks.NewEqualityComparison().Check(pass, testFunc)
+				}
+
+				if l.errorSemantics {
+					checks.NewErrorSemantics().Check(pass, testFunc)
+				}
+
+				if l.gotBeforeWant {
+					checks.NewGotBeforeWant().Check(pass, testFunc)
+				}
+
+				if l.identifyFunction {
+					checks.NewIdentifyFunction().Check(pass, testFunc)
+				}
 
-			if l.identifyFunction {
-				checks.NewIdentifyFunction().Check(pass, testFunc)
+				if l.identifyInput {
+					checks.NewIdentifyInput().Check(pass, testFunc)
+				}
+
+				if l.keepGoing {
+					checks.NewKeepGoing().Check(pass, testFunc)
+				}
+
+				if l.markTestHelpers {
+					checks.NewMarkTestHelpers().CheckHelperCalls(pass, testFunc)
+				}
+
+				if l.printDiffs.enabled {
+					pdCheck.Check(pass, testFunc)
+				}
+
+				if l.subtestNames {
+					snCheck.Check(pass, testFunc)
+				}
+
+				if l.useSubtests {
+					checks.NewUseSubtests().Check(pass, testFunc)
+				}
 			}
 		}
 	})
@@ -133,3 +297,20 @@ func (l *testcommentslint) run(pass *analysis.Pass) (any, error) {
 	//nolint:nilnil //any, error
 	return nil, nil
 }
+
+// exportHelperFacts exports a HelperFact for each function that compares two of its parameters and reports
+// the failure through a testing handle.
+func exportHelperFacts(pass *analysis.Pass) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			if fact, isHelper := model.NewHelperFact(pass.TypesInfo, funcDecl); isHelper {
+				pass.ExportObjectFact(pass.TypesInfo.Defs[funcDecl.Name], fact)
+			}
+		}
+	}
+}
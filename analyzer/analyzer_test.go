@@ -13,12 +13,64 @@ func TestAnalyzer(t *testing.T) {
 		patterns string
 		options  map[string]string
 	}{
+		"assertion library": {
+			patterns: "assertion_library",
+			options: map[string]string{
+				AssertionLibraryCheckName:   "true",
+				EqualityComparisonCheckName: "false",
+			},
+		},
+		"assertion library extra packages": {
+			patterns: "assertion_library_extra",
+			options: map[string]string{
+				AssertionLibraryCheckName:         "true",
+				AssertionLibraryCheckPackagesName: "github.com/acme/should",
+			},
+		},
+		"compare full structures": {
+			patterns: "compare_full_structures",
+			options: map[string]string{
+				CompareFullStructuresCheckName: "true",
+				EqualityComparisonCheckName:    "false",
+			},
+		},
+		"compare stable results": {
+			patterns: "compare_stable_results",
+			options: map[string]string{
+				CompareStableResultsCheckName: "true",
+				EqualityComparisonCheckName:   "false",
+				IdentifyTheFunctionCHeck:      "false",
+			},
+		},
+		"compare stable results custom serializers": {
+			patterns: "compare_stable_results_custom",
+			options: map[string]string{
+				CompareStableResultsCheckName:        "true",
+				CompareStableResultsCheckSerializers: "compare_stable_results_custom.encode",
+			},
+		},
+		"diff direction": {
+			patterns: "diff_direction",
+			options: map[string]string{
+				DiffDirectionCheckName:      "true",
+				EqualityComparisonCheckName: "false",
+				IdentifyTheFunctionCHeck:    "false",
+			},
+		},
 		"equality comparison": {
 			patterns: "equality_comparison",
 			options: map[string]string{
 				IdentifyTheFunctionCHeck: "false",
 			},
 		},
+		"error semantics": {
+			patterns: "error_semantics",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				ErrorSemanticsCheckName:     "true",
+				GotBeforeWantCheck:          "false",
+			},
+		},
 		"got before want": {
 			patterns: "got_before_want",
 			options: map[string]string{
@@ -31,6 +83,70 @@ func TestAnalyzer(t *testing.T) {
 			patterns: "identify_function",
 			options: map[string]string{
 				EqualityComparisonCheckName: "false",
+				IdentifyTheInputCheckName:   "true",
+			},
+		},
+		"helper facts": {
+			patterns: "helper_facts",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				MarkTestHelpersCheckName:    "true",
+			},
+		},
+		"identify input": {
+			patterns: "identify_input",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				IdentifyTheFunctionCHeck:    "false",
+				IdentifyTheInputCheckName:   "true",
+			},
+		},
+		"keep going": {
+			patterns: "keep_going",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				IdentifyTheFunctionCHeck:    "false",
+				KeepGoingCheckName:          "true",
+			},
+		},
+		"mark test helpers": {
+			patterns: "mark_test_helpers",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				MarkTestHelpersCheckName:    "true",
+			},
+		},
+		"print diffs": {
+			patterns: "print_diffs",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				IdentifyTheFunctionCHeck:    "false",
+				PrintDiffsCheckName:         "true",
+			},
+		},
+		"print diffs only structs": {
+			patterns: "print_diffs_structs",
+			options: map[string]string{
+				EqualityComparisonCheckName:    "false",
+				IdentifyTheFunctionCHeck:       "false",
+				PrintDiffsCheckName:            "true",
+				PrintDiffsCheckKindsName:       "struct",
+				PrintDiffsCheckMinStructFields: "3",
+			},
+		},
+		"subtest names": {
+			patterns: "subtest_names",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				SubtestNamesCheckName:       "true",
+			},
+		},
+		"test kinds": {
+			patterns: "test_kinds",
+			options: map[string]string{
+				EqualityComparisonCheckName: "false",
+				IdentifyTheFunctionCHeck:    "false",
+				KeepGoingCheckName:          "true",
 			},
 		},
 		"table-driven test format map-inlined": {
@@ -43,10 +159,39 @@ func TestAnalyzer(t *testing.T) {
 		"table-driven test format map-non-inlined": {
 			patterns: "table-driven-testing-format/map-non-inlined",
 			options: map[string]string{
+				SubtestNamesCheckName:             "true",
 				TableDrivenFormatCheckTypeName:    "map",
 				TableDrivenFormatCheckInlinedName: "false",
 			},
 		},
+		"table-driven test format shared tables": {
+			patterns: "table-driven-testing-format/shared",
+			options: map[string]string{
+				TableDrivenFormatCheckTypeName:    "map",
+				TableDrivenFormatCheckInlinedName: "true",
+			},
+		},
+		"table-driven test format slice-inlined": {
+			patterns: "table-driven-testing-format/slice-inlined",
+			options: map[string]string{
+				TableDrivenFormatCheckTypeName:    "slice",
+				TableDrivenFormatCheckInlinedName: "true",
+			},
+		},
+		"table-driven test format slice-non-inlined": {
+			patterns: "table-driven-testing-format/slice-non-inlined",
+			options: map[string]string{
+				TableDrivenFormatCheckTypeName:    "slice",
+				TableDrivenFormatCheckInlinedName: "false",
+			},
+		},
+		"use subtests": {
+			patterns: "use_subtests",
+			options: map[string]string{
+				KeepGoingCheckName:   "true",
+				UseSubtestsCheckName: "true",
+			},
+		},
 	}
 
 	for name, test := range testCases {
@@ -62,7 +207,7 @@ func TestAnalyzer(t *testing.T) {
 				}
 			}
 
-			analysistest.Run(t, analysistest.TestData(), a, test.patterns)
+			analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), a, test.patterns)
 		})
 	}
 }
@@ -0,0 +1,319 @@
+package checks
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+const (
+	testifyAssertPkgPath  = "github.com/stretchr/testify/assert"
+	testifyRequirePkgPath = "github.com/stretchr/testify/require"
+)
+
+// DefaultAssertionLibraries contains the packages of the assertion libraries reported by default.
+//
+//nolint:gochecknoglobals // read-only list
+var DefaultAssertionLibraries = []string{
+	testifyAssertPkgPath,
+	testifyRequirePkgPath,
+	"gotest.tools/assert",
+	"gotest.tools/v3/assert",
+	"github.com/onsi/gomega",
+	"github.com/smartystreets/goconvey/convey",
+	"github.com/matryer/is",
+	"github.com/go-playground/assert",
+}
+
+// AssertionLibrary check that the tests don't use assertion libraries, and that they compare the values with cmp
+// and report the failures with t.Errorf instead.
+type AssertionLibrary struct {
+	category string
+
+	packages []string
+}
+
+// NewAssertionLibrary creates a new AssertionLibrary that reports the calls into the packages, or their subpackages.
+func NewAssertionLibrary(packages []string) AssertionLibrary {
+	return AssertionLibrary{
+		category: "Assertion Libraries",
+		packages: packages,
+	}
+}
+
+// Check checks that the test function does not call any function or method of an assertion library.
+func (c AssertionLibrary) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	// the fuzz callbacks are inspected as part of their fuzz target.
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzKind, model.HelperKind) {
+		return
+	}
+
+	for _, assertionCallExpr := range testFunc.AssertionCallExprs(c.isAssertionFunc) {
+		diag := analysis.Diagnostic{
+			Pos:      assertionCallExpr.CallExpr().Pos(),
+			End:      assertionCallExpr.CallExpr().End(),
+			Category: c.category,
+			Message: "Assertion library " + strconv.Quote(assertionCallExpr.Func().Pkg().Path()) +
+				" should not be used, compare the values with cmp and report the failure with t.Errorf",
+			URL:            "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#assertion-libraries",
+			SuggestedFixes: c.suggestedFixes(pass, assertionCallExpr),
+		}
+		pass.Report(diag)
+	}
+}
+
+// isAssertionFunc returns whether the function, or the method, is declared in any of the packages.
+func (c AssertionLibrary) isAssertionFunc(fn *types.Func) bool {
+	pkgPath := fn.Pkg().Path()
+
+	for _, pkg := range c.packages {
+		if pkgPath == pkg || strings.HasPrefix(pkgPath, pkg+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suggestedFixes returns a fix that rewrites the testify `assert.Equal(t, want, got)` and `require.Equal(t, want, got)`
+// statements into:
+//
+//	if !cmp.Equal(got, want) {
+//		t.Errorf("YourFunc(%v) = %v, want %v", in, got, want)
+//	}
+//
+// The want and got values that can't be evaluated again, like calls, are assigned to `want` and `got` variables
+// first. The go-cmp import is added if the file does not import it yet. The fix is not offered when the first
+// argument is not a testing handle, or when the compared values have unexported fields, since cmp.Equal panics with
+// them.
+func (c AssertionLibrary) suggestedFixes(
+	pass *analysis.Pass,
+	assertionCallExpr model.AssertionCallExpr,
+) []analysis.SuggestedFix {
+	fn := assertionCallExpr.Func()
+
+	reporter := map[string]string{
+		testifyAssertPkgPath:  "Errorf",
+		testifyRequirePkgPath: "Fatalf",
+	}[fn.Pkg().Path()]
+
+	stmt := assertionCallExpr.Stmt()
+	callExpr := assertionCallExpr.CallExpr()
+
+	if reporter == "" || fn.Name() != "Equal" || stmt == nil || len(callExpr.Args) != 3 {
+		return nil
+	}
+
+	if sig, ok := fn.Type().(*types.Signature); !ok || sig.Recv() != nil {
+		return nil
+	}
+
+	if !model.IsTestingHandle(pass.TypesInfo, callExpr.Args[0]) ||
+		hasUnexportedFields(pass.TypesInfo.TypeOf(callExpr.Args[1]), make(map[types.Type]bool)) ||
+		hasUnexportedFields(pass.TypesInfo.TypeOf(callExpr.Args[2]), make(map[types.Type]bool)) {
+		return nil
+	}
+
+	file := fileOf(pass, stmt)
+	if file == nil {
+		return nil
+	}
+
+	cmpName, importEdits, ok := goCmpImport(file)
+	if !ok {
+		return nil
+	}
+
+	tText := nodeText(pass.Fset, callExpr.Args[0])
+	wantText := nodeText(pass.Fset, callExpr.Args[1])
+	gotText := nodeText(pass.Fset, callExpr.Args[2])
+
+	failureMessage, failureArgs := `"got %v, want %v"`, []string{}
+
+	if testedFunc, found := assertionCallExpr.TestedFunc(); found && testedFunc.FunctionName() != "" {
+		if verbs, inputsText, ok := testedCallInputs(pass.Fset, testedFunc); ok {
+			failureMessage = `"` + testedFunc.FunctionName() + "(" + verbs + `) = %v, want %v"`
+			failureArgs = inputsText
+		}
+	}
+
+	indent := strings.Repeat("\t", pass.Fset.Position(stmt.Pos()).Column-1)
+
+	// the values are compared and printed, so the ones that can't be evaluated again, like calls, are assigned first,
+	// in the order the assertion evaluates them.
+	declarations := ""
+
+	for _, value := range []struct {
+		name string
+		arg  ast.Expr
+		text *string
+	}{
+		{name: "want", arg: callExpr.Args[1], text: &wantText},
+		{name: "got", arg: callExpr.Args[2], text: &gotText},
+	} {
+		if isSideEffectFree(value.arg) {
+			continue
+		}
+
+		if isNameInScope(pass, stmt.Pos(), value.name) {
+			return nil
+		}
+
+		declarations += value.name + " := " + *value.text + "\n" + indent
+		*value.text = value.name
+	}
+
+	failureArgs = append(failureArgs, gotText, wantText)
+	newText := declarations + "if !" + cmpName + ".Equal(" + gotText + ", " + wantText + ") {\n" +
+		indent + "\t" + tText + "." + reporter + "(" + failureMessage + ", " + strings.Join(failureArgs, ", ") + ")\n" +
+		indent + "}"
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "Replace " + fn.Pkg().Name() + ".Equal by cmp.Equal",
+			TextEdits: append([]analysis.TextEdit{
+				{
+					Pos:     stmt.Pos(),
+					End:     stmt.End(),
+					NewText: []byte(newText),
+				},
+			}, importEdits...),
+		},
+	}
+}
+
+// hasUnexportedFields returns whether the type, or any type it contains, is a struct with unexported fields, that make
+// cmp.Equal panic. The types with an Equal method are compared with it, so their fields are not inspected.
+func hasUnexportedFields(t types.Type, seen map[types.Type]bool) bool {
+	if t == nil {
+		return true
+	}
+
+	if seen[t] || hasEqualMethod(t) {
+		return false
+	}
+
+	seen[t] = true
+
+	switch underlying := t.Underlying().(type) {
+	case *types.Struct:
+		for i := range underlying.NumFields() {
+			field := underlying.Field(i)
+			if !field.Exported() || hasUnexportedFields(field.Type(), seen) {
+				return true
+			}
+		}
+	case *types.Pointer:
+		return hasUnexportedFields(underlying.Elem(), seen)
+	case *types.Slice:
+		return hasUnexportedFields(underlying.Elem(), seen)
+	case *types.Array:
+		return hasUnexportedFields(underlying.Elem(), seen)
+	case *types.Map:
+		return hasUnexportedFields(underlying.Key(), seen) || hasUnexportedFields(underlying.Elem(), seen)
+	}
+
+	return false
+}
+
+// hasEqualMethod returns whether the type has an `Equal` method that receives one value and returns a bool, like
+// time.Time, that cmp.Equal uses to compare the values.
+func hasEqualMethod(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, "Equal")
+
+	method, isFunc := obj.(*types.Func)
+	if !isFunc {
+		return false
+	}
+
+	sig, isSignature := method.Type().(*types.Signature)
+	if !isSignature || sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+
+	basic, isBasic := sig.Results().At(0).Type().(*types.Basic)
+
+	return isBasic && basic.Kind() == types.Bool
+}
+
+// goCmpImport returns the name of the go-cmp package in the file, and the edits to import it if it's not imported yet.
+// The import is added to the last group of imports, or to a new group if the last one contains standard library
+// packages. It returns false if the package can't be imported with its name, because the name is already in use.
+func goCmpImport(file *ast.File) (string, []analysis.TextEdit, bool) {
+	for _, importSpec := range file.Imports {
+		path, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+
+		if path == model.GoCmpPkgPath && name != "_" && name != "." {
+			return name, nil, true
+		}
+
+		if name == "cmp" {
+			return "", nil, false
+		}
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.IMPORT || !genDecl.Lparen.IsValid() {
+			continue
+		}
+
+		newText := "\t" + strconv.Quote(model.GoCmpPkgPath) + "\n"
+		if len(genDecl.Specs) > 0 && isStdlibImport(genDecl.Specs[len(genDecl.Specs)-1]) {
+			newText = "\n" + newText
+		}
+
+		return "cmp", []analysis.TextEdit{
+			{
+				Pos:     genDecl.Rparen,
+				End:     genDecl.Rparen,
+				NewText: []byte(newText),
+			},
+		}, true
+	}
+
+	return "", nil, false
+}
+
+// isStdlibImport returns whether the import is a package of the standard library, whose path has no dot in its first
+// element, like "net/http".
+func isStdlibImport(spec ast.Spec) bool {
+	importSpec, isImportSpec := spec.(*ast.ImportSpec)
+	if !isImportSpec {
+		return false
+	}
+
+	path, err := strconv.Unquote(importSpec.Path.Value)
+	if err != nil {
+		return false
+	}
+
+	first, _, _ := strings.Cut(path, "/")
+
+	return !strings.Contains(first, ".")
+}
+
+// fileOf returns the file of the pass that contains the node.
+func fileOf(pass *analysis.Pass, node ast.Node) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= node.Pos() && node.Pos() < file.FileEnd {
+			return file
+		}
+	}
+
+	return nil
+}
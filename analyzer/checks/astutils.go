@@ -0,0 +1,76 @@
+package checks
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// nodeText returns the source code of the node, or an empty string if it can't be printed.
+func nodeText(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, node); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// testedCallInputs returns the verbs, like `%v, %v`, and the source code of the inputs of the tested call, to print
+// them in a failure message like `YourFunc(%v, %v) = %v, want %v`. It returns false if any input can't be copied
+// into the failure message without evaluating it again, like a call, whose side effects would run twice.
+func testedCallInputs(fset *token.FileSet, testedFunc model.TestedCallExpr) (string, []string, bool) {
+	inputs := testedFunc.CallExpr().Args
+	verbs := make([]string, len(inputs))
+	inputsText := make([]string, len(inputs))
+
+	for i, input := range inputs {
+		if !isSideEffectFree(input) {
+			return "", nil, false
+		}
+
+		verbs[i] = "%v"
+
+		inputsText[i] = nodeText(fset, input)
+		if inputsText[i] == "" {
+			return "", nil, false
+		}
+	}
+
+	return strings.Join(verbs, ", "), inputsText, true
+}
+
+// isSideEffectFree returns whether the expression can be evaluated again without side effects, it's an identifier,
+// a selector of one, or a literal, like in `in`, `test.in`, `"a"` or `-1`.
+func isSideEffectFree(expr ast.Expr) bool {
+	switch node := expr.(type) {
+	case *ast.Ident, *ast.BasicLit:
+		return true
+	case *ast.SelectorExpr:
+		return isSideEffectFree(node.X)
+	case *ast.ParenExpr:
+		return isSideEffectFree(node.X)
+	case *ast.UnaryExpr:
+		_, isBasicLit := node.X.(*ast.BasicLit)
+
+		return isBasicLit && (node.Op == token.SUB || node.Op == token.ADD)
+	default:
+		return false
+	}
+}
+
+// helperFactOf returns a model.HelperFactOf that imports the facts of the pass.
+func helperFactOf(pass *analysis.Pass) model.HelperFactOf {
+	return func(fn *types.Func) (*model.HelperFact, bool) {
+		fact := new(model.HelperFact)
+
+		return fact, pass.ImportObjectFact(fn, fact)
+	}
+}
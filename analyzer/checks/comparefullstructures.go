@@ -0,0 +1,275 @@
+package checks
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// CompareFullStructures check that the structs are compared in a single comparison, and not field by field.
+type CompareFullStructures struct {
+	category string
+}
+
+// NewCompareFullStructures creates a new CompareFullStructures.
+func NewCompareFullStructures() CompareFullStructures {
+	return CompareFullStructures{
+		category: "Compare Full Structures",
+	}
+}
+
+// fieldComparison is an if statement that compares a field of two values of the same struct type, like in:
+//
+//	if got.Name != want.Name {
+//		t.Errorf(...)
+//	}
+type fieldComparison struct {
+	ifStmt *ast.IfStmt
+	// got is the root of the field read in the left hand side, like got in got.Name.
+	got ast.Expr
+	// want is the root of the field read in the right hand side, like want in want.Name.
+	want ast.Expr
+	// field is the name of the field compared, like Name in got.Name.
+	field string
+	// reporter is the call that reports the failure.
+	reporter model.TErrorfCallExpr
+}
+
+// Check checks that there are not consecutive if statements comparing the fields of the same two structs, like in:
+//
+//	if got.Name != want.Name {
+//		t.Errorf(...)
+//	}
+//	if got.Age != want.Age {
+//		t.Errorf(...)
+//	}
+func (c CompareFullStructures) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
+	for _, blStmt := range testFunc.ComparisonBlockStmts() {
+		c.checkBlockStmt(pass, blStmt)
+	}
+}
+
+// checkBlockStmt reports the runs of consecutive field comparisons of the statements of the block.
+func (c CompareFullStructures) checkBlockStmt(pass *analysis.Pass, blStmt *ast.BlockStmt) {
+	var run []fieldComparison
+
+	for i, stmt := range blStmt.List {
+		comparison, ok := newFieldComparison(pass.TypesInfo, stmt)
+		if ok && len(run) > 0 && !run[0].isSameRoots(comparison) {
+			c.reportRun(pass, blStmt.List[:i-len(run)], run)
+			run = nil
+		}
+
+		if ok {
+			run = append(run, comparison)
+
+			continue
+		}
+
+		c.reportRun(pass, blStmt.List[:i-len(run)], run)
+		run = nil
+	}
+
+	c.reportRun(pass, blStmt.List[:len(blStmt.List)-len(run)], run)
+}
+
+// reportRun reports the run of field comparisons if there are at least two of them, the previous statements are
+// used to find the tested function.
+func (c CompareFullStructures) reportRun(pass *analysis.Pass, prevStmts []ast.Stmt, run []fieldComparison) {
+	if len(run) < 2 {
+		return
+	}
+
+	first, last := run[0], run[len(run)-1]
+
+	diag := analysis.Diagnostic{
+		Pos:      first.ifStmt.Pos(),
+		End:      last.ifStmt.End(),
+		Category: c.category,
+		Message: "Compare the full structures instead of field by field, " +
+			"like in `if diff := cmp.Diff(want, got); diff != \"\"`",
+		URL:            "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#compare-full-structures",
+		SuggestedFixes: c.suggestedFixes(pass, prevStmts, run),
+	}
+	pass.Report(diag)
+}
+
+// suggestedFixes returns a fix that replaces the run of field comparisons by a single cmp.Diff comparison:
+//
+//	if diff := cmp.Diff(want, got); diff != "" {
+//		t.Errorf("YourFunc(%v) mismatch (-want +got):\n%s", in, diff)
+//	}
+//
+// The fix is offered only if the run compares all the fields of the struct, and all of them are exported, so the
+// test keeps asserting the same and cmp.Diff does not panic. The go-cmp import is added if the file does not import
+// it yet.
+func (c CompareFullStructures) suggestedFixes(
+	pass *analysis.Pass,
+	prevStmts []ast.Stmt,
+	run []fieldComparison,
+) []analysis.SuggestedFix {
+	first, last := run[0], run[len(run)-1]
+
+	if !comparesAllExportedFields(pass.TypesInfo, run) {
+		return nil
+	}
+
+	file := fileOf(pass, first.ifStmt)
+	if file == nil {
+		return nil
+	}
+
+	cmpName, importEdits, ok := goCmpImport(file)
+	if !ok {
+		return nil
+	}
+
+	selectorExpr, ok := first.reporter.CallExpr().Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	reporter := "Errorf"
+	if first.reporter.Kind().Fatal {
+		reporter = "Fatalf"
+	}
+
+	failureMessage, failureArgs := `"mismatch (-want +got):\n%s"`, []string{"diff"}
+	got, want := first.got, first.want
+
+	testedFunc, found := testedCallExprOf(pass.TypesInfo, prevStmts, got)
+	if !found {
+		// the values may be compared as in want.Name != got.Name.
+		if testedFunc, found = testedCallExprOf(pass.TypesInfo, prevStmts, want); found {
+			got, want = want, got
+		}
+	}
+
+	if found && testedFunc.FunctionName() != "" {
+		if verbs, inputsText, ok := testedCallInputs(pass.Fset, testedFunc); ok {
+			failureMessage = `"` + testedFunc.FunctionName() + "(" + verbs + `) mismatch (-want +got):\n%s"`
+			failureArgs = append(inputsText, failureArgs...)
+		}
+	}
+
+	indent := strings.Repeat("\t", pass.Fset.Position(first.ifStmt.Pos()).Column-1)
+	newText := "if diff := " + cmpName + ".Diff(" + nodeText(pass.Fset, want) + ", " +
+		nodeText(pass.Fset, got) + "); diff != \"\" {\n" +
+		indent + "\t" + nodeText(pass.Fset, selectorExpr.X) + "." + reporter + "(" + failureMessage + ", " +
+		strings.Join(failureArgs, ", ") + ")\n" +
+		indent + "}"
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "Replace the field comparisons by cmp.Diff",
+			TextEdits: append([]analysis.TextEdit{
+				{
+					Pos:     first.ifStmt.Pos(),
+					End:     last.ifStmt.End(),
+					NewText: []byte(newText),
+				},
+			}, importEdits...),
+		},
+	}
+}
+
+// comparesAllExportedFields returns whether the run compares every field of the struct type, and all the fields are
+// exported.
+func comparesAllExportedFields(info *types.Info, run []fieldComparison) bool {
+	structType, isStruct := deref(info.TypeOf(run[0].got)).Underlying().(*types.Struct)
+	if !isStruct {
+		return false
+	}
+
+	compared := make(map[string]bool, len(run))
+	for _, comparison := range run {
+		compared[comparison.field] = true
+	}
+
+	for i := range structType.NumFields() {
+		field := structType.Field(i)
+		if !field.Exported() || !compared[field.Name()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// testedCallExprOf returns the call to the tested function that produced the value, if the value is a variable.
+func testedCallExprOf(info *types.Info, prevStmts []ast.Stmt, expr ast.Expr) (model.TestedCallExpr, bool) {
+	ident, isIdent := expr.(*ast.Ident)
+	if !isIdent {
+		return model.TestedCallExpr{}, false
+	}
+
+	return model.TestedCallExprOf(info, prevStmts, ident)
+}
+
+// newFieldComparison returns the fieldComparison if the statement is an if statement, without init or else,
+// that compares the same field of two values of the same struct type and reports the failure.
+func newFieldComparison(info *types.Info, stmt ast.Stmt) (fieldComparison, bool) {
+	ifStmt, isIfStmt := stmt.(*ast.IfStmt)
+	if !isIfStmt || ifStmt.Init != nil || ifStmt.Else != nil {
+		return fieldComparison{}, false
+	}
+
+	binaryExpr, isBinaryExpr := ifStmt.Cond.(*ast.BinaryExpr)
+	if !isBinaryExpr || binaryExpr.Op != token.NEQ {
+		return fieldComparison{}, false
+	}
+
+	x, isXSelector := binaryExpr.X.(*ast.SelectorExpr)
+	y, isYSelector := binaryExpr.Y.(*ast.SelectorExpr)
+
+	if !isXSelector || !isYSelector || x.Sel.Name != y.Sel.Name || types.ExprString(x.X) == types.ExprString(y.X) {
+		return fieldComparison{}, false
+	}
+
+	if !isSameStructType(info.TypeOf(x.X), info.TypeOf(y.X)) {
+		return fieldComparison{}, false
+	}
+
+	reporters, isReporting := model.NewTErrorfCallExprs(info, ifStmt.Body)
+	if !isReporting {
+		return fieldComparison{}, false
+	}
+
+	return fieldComparison{
+		ifStmt:   ifStmt,
+		got:      x.X,
+		want:     y.X,
+		field:    x.Sel.Name,
+		reporter: reporters[0],
+	}, true
+}
+
+// isSameRoots returns whether both comparisons read the fields of the same values.
+func (f fieldComparison) isSameRoots(other fieldComparison) bool {
+	return types.ExprString(f.got) == types.ExprString(other.got) &&
+		types.ExprString(f.want) == types.ExprString(other.want)
+}
+
+// isSameStructType returns whether both types are the same struct type, or pointer to struct type.
+func isSameStructType(a, b types.Type) bool {
+	if a == nil || b == nil || !types.Identical(a, b) {
+		return false
+	}
+
+	if ptr, isPtr := a.Underlying().(*types.Pointer); isPtr {
+		a = ptr.Elem()
+	}
+
+	_, isStruct := a.Underlying().(*types.Struct)
+
+	return isStruct
+}
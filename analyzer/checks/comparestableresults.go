@@ -0,0 +1,342 @@
+package checks
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// DefaultUnstableSerializers contains the functions, as package path and function name, whose output is not
+// guaranteed to be stable. fmt.Sprint is only reported when it prints composite values, see fmtSprint.
+//
+//nolint:gochecknoglobals // read-only list
+var DefaultUnstableSerializers = []string{
+	"encoding/json.Marshal",
+	"encoding/json.MarshalIndent",
+	"encoding/xml.Marshal",
+	"encoding/xml.MarshalIndent",
+	"fmt.Sprint",
+	"github.com/golang/protobuf/proto.Marshal",
+	"google.golang.org/protobuf/encoding/protojson.Marshal",
+	"google.golang.org/protobuf/encoding/prototext.Marshal",
+	"google.golang.org/protobuf/proto.Marshal",
+}
+
+// fmtSprint is the serializer that is only unstable when printing composite values, like structs with pointers,
+// since the output of the basic values is deterministic.
+const fmtSprint = "fmt.Sprint"
+
+type (
+	// CompareStableResults check that the tests don't compare the output of functions whose output is not stable,
+	// like json.Marshal or proto.Marshal, and compare the values semantically instead.
+	CompareStableResults struct {
+		category string
+
+		serializers map[string]bool
+	}
+
+	CompareStableResultsSerializerError struct {
+		requestedSerializer string
+	}
+)
+
+func (e CompareStableResultsSerializerError) Error() string {
+	return fmt.Sprintf("serializer not expected, it should be <package path>.<function>: %q", e.requestedSerializer)
+}
+
+// NewCompareStableResults creates a new CompareStableResults that reports the comparisons of the output of
+// the serializers, each of them in the format <package path>.<function>, like encoding/json.Marshal.
+func NewCompareStableResults(serializers []string) (CompareStableResults, error) {
+	serializersSet := make(map[string]bool, len(serializers))
+
+	for _, serializer := range serializers {
+		dot := strings.LastIndex(serializer, ".")
+		if dot <= 0 || dot == len(serializer)-1 {
+			return CompareStableResults{}, CompareStableResultsSerializerError{requestedSerializer: serializer}
+		}
+
+		serializersSet[serializer] = true
+	}
+
+	return CompareStableResults{
+		category:    "Compare Stable Results",
+		serializers: serializersSet,
+	}, nil
+}
+
+// Check checks that the got value of the comparisons is not the output of an unstable serializer,
+// or its conversion to string, like in `string(b)` with `b, err := json.Marshal(v)`.
+func (c CompareStableResults) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
+	reported := make(map[*ast.IfStmt]bool)
+
+	for _, testBlock := range testFunc.TestPartBlocks() {
+		ifStmt := testBlock.IfComparing().IfStmt()
+		if reported[ifStmt] {
+			continue
+		}
+
+		if ifComparing, ok := testBlock.IfComparing().(model.ComparingParamsIfStmt); ok &&
+			model.IsError(pass.TypesInfo, ifComparing.Got()) {
+			continue
+		}
+
+		blStmt := testFunc.ComparisonBlockStmtOf(ifStmt)
+		if blStmt == nil {
+			continue
+		}
+
+		serializer, ok := c.serializerOf(pass.TypesInfo, blStmt.List, testBlock.TestedFunc().CallExpr())
+		if !ok {
+			continue
+		}
+
+		reported[ifStmt] = true
+
+		diag := analysis.Diagnostic{
+			Pos:      ifStmt.Cond.Pos(),
+			End:      ifStmt.Cond.End(),
+			Category: c.category,
+			Message: "The output of " + serializer.Pkg().Name() + "." + serializer.Name() + " is not stable, " +
+				"parse it and compare the values semantically, like with cmp.Diff on the unmarshalled values " +
+				"or with protocmp.Transform()",
+			URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#compare-stable-results",
+		}
+		pass.Report(diag)
+	}
+
+	for _, blStmt := range testFunc.ComparisonBlockStmts() {
+		c.checkMapOrder(pass, blStmt)
+	}
+}
+
+// checkMapOrder reports the loops over a map that append to a slice that is compared afterwards without being sorted,
+// since the order of its elements depends on the map iteration order.
+func (c CompareStableResults) checkMapOrder(pass *analysis.Pass, blStmt *ast.BlockStmt) {
+	reported := make(map[*ast.RangeStmt]bool)
+
+	for i, stmt := range blStmt.List {
+		ifStmt, isIfStmt := stmt.(*ast.IfStmt)
+		if !isIfStmt {
+			continue
+		}
+
+		for _, operand := range comparedOperands(pass.TypesInfo, ifStmt) {
+			rangeStmt, ok := mapRangeAppending(pass.TypesInfo, blStmt.List[:i], operand)
+			if !ok || reported[rangeStmt] {
+				continue
+			}
+
+			reported[rangeStmt] = true
+
+			diag := analysis.Diagnostic{
+				Pos:      rangeStmt.Pos(),
+				End:      rangeStmt.X.End(),
+				Category: c.category,
+				Message: "The order of " + operand.Name + " depends on the map iteration order, sort it before " +
+					"comparing it, or compare it with cmpopts.SortSlices",
+				URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#compare-stable-results",
+			}
+			pass.Report(diag)
+		}
+	}
+}
+
+// comparedOperands returns the slice variables compared by cmp.Diff, cmp.Equal or reflect.DeepEqual in the if
+// statement, either in its init or in its condition.
+func comparedOperands(info *types.Info, ifStmt *ast.IfStmt) []*ast.Ident {
+	operands := make([]*ast.Ident, 0)
+
+	for _, node := range []ast.Node{ifStmt.Init, ifStmt.Cond} {
+		if node == nil {
+			continue
+		}
+
+		ast.Inspect(node, func(n ast.Node) bool {
+			callExpr, isCallExpr := n.(*ast.CallExpr)
+			if !isCallExpr || len(callExpr.Args) < 2 || !model.IsGoCmpDiff(info, callExpr) &&
+				!model.IsGoCmpEqual(info, callExpr) && !model.IsReflectDeepEqual(info, callExpr) {
+				return true
+			}
+
+			for _, arg := range callExpr.Args[:2] {
+				ident, isIdent := ast.Unparen(arg).(*ast.Ident)
+				if !isIdent {
+					continue
+				}
+
+				if _, isSlice := info.TypeOf(ident).Underlying().(*types.Slice); isSlice {
+					operands = append(operands, ident)
+				}
+			}
+
+			return false
+		})
+	}
+
+	return operands
+}
+
+// mapRangeAppending returns the closest loop over a map, among the statements, that appends to the slice variable,
+// unless the slice is sorted or assigned again after the loop.
+func mapRangeAppending(info *types.Info, stmts []ast.Stmt, ident *ast.Ident) (*ast.RangeStmt, bool) {
+	obj := info.ObjectOf(ident)
+
+	for i := len(stmts) - 1; i >= 0; i-- {
+		switch stmt := stmts[i].(type) {
+		case *ast.ExprStmt:
+			if callExpr, isCallExpr := stmt.X.(*ast.CallExpr); isCallExpr && isSortCall(info, callExpr, obj) {
+				return nil, false
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range stmt.Lhs {
+				if lhsIdent, isIdent := lhs.(*ast.Ident); isIdent && info.ObjectOf(lhsIdent) == obj {
+					return nil, false
+				}
+			}
+		case *ast.RangeStmt:
+			if _, isMap := info.TypeOf(stmt.X).Underlying().(*types.Map); isMap && appendsTo(info, stmt.Body, obj) {
+				return stmt, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// appendsTo returns whether the node contains an assignment that appends to the variable, like `got = append(got, k)`.
+func appendsTo(info *types.Info, node ast.Node, obj types.Object) bool {
+	found := false
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		assignStmt, isAssignStmt := n.(*ast.AssignStmt)
+		if !isAssignStmt || len(assignStmt.Lhs) != 1 || len(assignStmt.Rhs) != 1 {
+			return !found
+		}
+
+		lhsIdent, isIdent := assignStmt.Lhs[0].(*ast.Ident)
+		callExpr, isCallExpr := assignStmt.Rhs[0].(*ast.CallExpr)
+
+		if isIdent && isCallExpr && info.ObjectOf(lhsIdent) == obj {
+			if builtin, isBuiltin := typeutil.Callee(info, callExpr).(*types.Builtin); isBuiltin &&
+				builtin.Name() == "append" {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isSortCall returns whether the call sorts the variable, with the sort package or the slices.Sort functions.
+func isSortCall(info *types.Info, callExpr *ast.CallExpr, obj types.Object) bool {
+	fn := typeutil.StaticCallee(info, callExpr)
+	if fn == nil || fn.Pkg() == nil ||
+		fn.Pkg().Path() != "sort" && (fn.Pkg().Path() != "slices" || !strings.HasPrefix(fn.Name(), "Sort")) {
+		return false
+	}
+
+	found := false
+
+	for _, arg := range callExpr.Args {
+		ast.Inspect(arg, func(n ast.Node) bool {
+			if ident, isIdent := n.(*ast.Ident); isIdent && info.ObjectOf(ident) == obj {
+				found = true
+			}
+
+			return !found
+		})
+	}
+
+	return found
+}
+
+// serializerOf returns the unstable serializer that produced the value of the call, either because the call is to
+// the serializer, or because it converts its output to string, like in `string(b)`. The serializer output is looked for
+// in the statements before the call.
+func (c CompareStableResults) serializerOf(
+	info *types.Info,
+	stmts []ast.Stmt,
+	callExpr *ast.CallExpr,
+) (*types.Func, bool) {
+	if fn := typeutil.StaticCallee(info, callExpr); fn != nil && fn.Pkg() != nil {
+		serializer := fn.Pkg().Path() + "." + fn.Name()
+		if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() == nil && c.serializers[serializer] &&
+			(serializer != fmtSprint || hasCompositeArg(info, callExpr)) {
+			return fn, true
+		}
+
+		return nil, false
+	}
+
+	if !isStringConversion(info, callExpr) {
+		return nil, false
+	}
+
+	switch arg := ast.Unparen(callExpr.Args[0]).(type) {
+	case *ast.CallExpr:
+		return c.serializerOf(info, stmts, arg)
+	case *ast.Ident:
+		prevStmts := make([]ast.Stmt, 0, len(stmts))
+
+		for _, stmt := range stmts {
+			if stmt.End() <= callExpr.Pos() {
+				prevStmts = append(prevStmts, stmt)
+			}
+		}
+
+		testedFunc, ok := model.TestedCallExprOf(info, prevStmts, arg)
+		if !ok {
+			return nil, false
+		}
+
+		return c.serializerOf(info, prevStmts, testedFunc.CallExpr())
+	}
+
+	return nil, false
+}
+
+// hasCompositeArg returns whether any argument of the call is a composite value, a struct, map, slice, array or
+// pointer.
+func hasCompositeArg(info *types.Info, callExpr *ast.CallExpr) bool {
+	for _, arg := range callExpr.Args {
+		t := info.TypeOf(arg)
+		if t == nil {
+			continue
+		}
+
+		switch t.Underlying().(type) {
+		case *types.Struct, *types.Map, *types.Slice, *types.Array, *types.Pointer:
+			return true
+		}
+	}
+
+	return false
+}
+
+// isStringConversion returns whether the call expression is a conversion to string, like in `string(b)`.
+func isStringConversion(info *types.Info, callExpr *ast.CallExpr) bool {
+	if len(callExpr.Args) != 1 {
+		return false
+	}
+
+	tv, ok := info.Types[callExpr.Fun]
+	if !ok || !tv.IsType() {
+		return false
+	}
+
+	basic, isBasic := tv.Type.Underlying().(*types.Basic)
+
+	return isBasic && basic.Info()&types.IsString != 0
+}
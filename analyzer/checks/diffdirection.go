@@ -0,0 +1,278 @@
+package checks
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+const (
+	wantGotLegend = "(-want +got)"
+	gotWantLegend = "(-got +want)"
+)
+
+// DiffDirection check that the legend of the failure message, like (-want +got), matches the order of the
+// cmp.Diff arguments, and that the diff is printed.
+type DiffDirection struct {
+	category string
+}
+
+// NewDiffDirection creates a new DiffDirection.
+func NewDiffDirection() DiffDirection {
+	return DiffDirection{
+		category: "Diff Direction",
+	}
+}
+
+var (
+	// diffLegendRegexp matches a parenthesised legend at the end of the text, only followed by separators, like in
+	// `mismatch (-want +got):\n`.
+	diffLegendRegexp = regexp.MustCompile(`(\(-(\w+),?\s+\+(\w+)\))(?:[\s:]|\\n|\\t)*$`)
+	// anyLegendRegexp matches a parenthesised legend anywhere in the text, like in `(-want +got) YourFunc(%v):\n%s`.
+	anyLegendRegexp = regexp.MustCompile(`(\(-(\w+),?\s+\+(\w+)\))`)
+	wantWordRegexp  = regexp.MustCompile(`(?i)^(want(ed)?|expect(ed)?|exp)$`)
+	gotWordRegexp   = regexp.MustCompile(`(?i)^(got|actual|result)$`)
+)
+
+// Check checks that the failure message of a cmp.Diff comparison prints the diff, and that it explains the direction
+// of the diff with the legend (-want +got) for cmp.Diff(want, got) or (-got +want) for cmp.Diff(got, want).
+func (c DiffDirection) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
+	for _, testBlock := range testFunc.TestPartBlocks() {
+		diffIfStmt, ok := testBlock.IfComparing().(model.DiffIfStmt)
+		if !ok {
+			continue
+		}
+
+		tErrorfCallExpr := testBlock.TErrorCallExpr()
+
+		diffIndex := -1
+
+		for i, arg := range tErrorfCallExpr.GetArgs() {
+			if isSameVariable(diffIfStmt.Diff(), arg) {
+				diffIndex = i
+
+				break
+			}
+		}
+
+		if diffIndex < 0 {
+			diag := analysis.Diagnostic{
+				Pos:      tErrorfCallExpr.CallExpr().Pos(),
+				End:      tErrorfCallExpr.CallExpr().End(),
+				Category: c.category,
+				Message:  "The diff returned by cmp.Diff should be printed in the failure message",
+				URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#diff-direction",
+			}
+			pass.Report(diag)
+
+			continue
+		}
+
+		legend, ok := expectedLegend(testBlock.TestedFunc(), diffIfStmt)
+		if !ok {
+			continue
+		}
+
+		c.checkLegend(pass, tErrorfCallExpr, legend, diffIndex)
+	}
+}
+
+// checkLegend checks that the failure message contains the expected legend, reporting a missing or wrong legend.
+func (c DiffDirection) checkLegend(
+	pass *analysis.Pass,
+	tErrorfCallExpr model.TErrorfCallExpr,
+	legend string,
+	diffIndex int,
+) {
+	// work with the literal as it's written in the source code, so interpreted and raw strings are supported.
+	literal := tErrorfCallExpr.FailureMessage()
+
+	diag := analysis.Diagnostic{
+		Pos:      tErrorfCallExpr.CallExpr().Pos(),
+		End:      tErrorfCallExpr.CallExpr().End(),
+		Category: c.category,
+		URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#diff-direction",
+	}
+
+	match := findLegend(tErrorfCallExpr, literal, diffIndex)
+	if match == nil {
+		diag.Message = "The failure message should explain the direction of the diff with the " + legend + " legend"
+		diag.SuggestedFixes = addLegendSuggestedFixes(tErrorfCallExpr, legend, diffIndex)
+		pass.Report(diag)
+
+		return
+	}
+
+	found := literal[match[2]:match[3]]
+	minus, plus := literal[match[4]:match[5]], literal[match[6]:match[7]]
+
+	var wantFirst bool
+
+	switch {
+	case wantWordRegexp.MatchString(minus) && gotWordRegexp.MatchString(plus):
+		wantFirst = true
+	case gotWordRegexp.MatchString(minus) && wantWordRegexp.MatchString(plus):
+		wantFirst = false
+	default:
+		// the words of the legend are unknown, so its direction can't be checked.
+		return
+	}
+
+	if wantFirst == (legend == wantGotLegend) {
+		return
+	}
+
+	basicLit, isBasicLit := tErrorfCallExpr.CallExpr().Args[0].(*ast.BasicLit)
+	if !isBasicLit {
+		return
+	}
+
+	diag.Message = "The diff legend " + found + " does not match the order of the cmp.Diff arguments, it should be " +
+		legend
+	diag.SuggestedFixes = []analysis.SuggestedFix{
+		{
+			Message: "Replace the legend by " + legend,
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     basicLit.Pos() + token.Pos(match[2]),
+					End:     basicLit.Pos() + token.Pos(match[3]),
+					NewText: []byte(legend),
+				},
+			},
+		},
+	}
+	pass.Report(diag)
+}
+
+// findLegend returns the submatch indexes of the legend in the literal. The legend right before the verb that prints
+// the diff, or at the end of the message if it's not formatted, like in t.Error("(-want +got):", diff), is preferred,
+// otherwise the last legend of the literal is returned, like in `(-want +got) YourFunc(%v) mismatch:\n%s`.
+func findLegend(tErrorfCallExpr model.TErrorfCallExpr, literal string, diffIndex int) []int {
+	if literal == "" {
+		return nil
+	}
+
+	if match := legendBeforeDiff(tErrorfCallExpr, literal, diffIndex); match != nil {
+		return match
+	}
+
+	matches := anyLegendRegexp.FindAllStringSubmatchIndex(literal, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	return matches[len(matches)-1]
+}
+
+// legendBeforeDiff returns the submatch indexes of diffLegendRegexp in the literal, when the legend is right before the
+// verb that prints the diff, or at the end of the message if it's not formatted.
+func legendBeforeDiff(tErrorfCallExpr model.TErrorfCallExpr, literal string, diffIndex int) []int {
+
+	// the last character of the literal is the closing quote.
+	end := len(literal) - 1
+
+	if tErrorfCallExpr.Kind().Formatted {
+		end = -1
+
+		for _, verb := range parseFormatVerbs(literal) {
+			if verb.argIndex == diffIndex {
+				end = verb.start
+
+				break
+			}
+		}
+
+		if end < 0 {
+			return nil
+		}
+	}
+
+	return diffLegendRegexp.FindStringSubmatchIndex(literal[:end])
+}
+
+// expectedLegend returns the legend that matches the order of the cmp.Diff arguments, (-want +got) if the tested
+// function result is the second argument, or (-got +want) if it is the first one.
+func expectedLegend(testedFunc model.TestedCallExpr, diffIfStmt model.DiffIfStmt) (string, bool) {
+	xIsGot := isTestedFuncResult(testedFunc, diffIfStmt.X())
+	yIsGot := isTestedFuncResult(testedFunc, diffIfStmt.Y())
+
+	switch {
+	case yIsGot && !xIsGot:
+		return wantGotLegend, true
+	case xIsGot && !yIsGot:
+		return gotWantLegend, true
+	default:
+		return "", false
+	}
+}
+
+// addLegendSuggestedFixes returns a fix that adds the legend right before the verb that prints the diff,
+// like in `YourFunc(%v) mismatch:\n%s` that is rewritten into `YourFunc(%v) mismatch (-want +got):\n%s`.
+func addLegendSuggestedFixes(
+	tErrorfCallExpr model.TErrorfCallExpr,
+	legend string,
+	diffIndex int,
+) []analysis.SuggestedFix {
+	if !tErrorfCallExpr.Kind().Formatted || diffIndex < 0 {
+		return nil
+	}
+
+	basicLit, ok := tErrorfCallExpr.CallExpr().Args[0].(*ast.BasicLit)
+	if !ok || basicLit.Kind != token.STRING {
+		return nil
+	}
+
+	literal := basicLit.Value
+
+	var diffVerb *formatVerb
+
+	for _, verb := range parseFormatVerbs(literal) {
+		if verb.argIndex == diffIndex {
+			diffVerb = &verb
+
+			break
+		}
+	}
+
+	if diffVerb == nil {
+		return nil
+	}
+
+	// the legend goes after the text that describes the mismatch, and before the separators that lead to the diff.
+	// The first character of the literal is the opening quote.
+	prefix := literal[1:diffVerb.start]
+	for trimmed := ""; trimmed != prefix; {
+		trimmed = prefix
+		prefix = strings.TrimSuffix(strings.TrimRight(prefix, " :\t\n"), `\n`)
+		prefix = strings.TrimSuffix(prefix, `\t`)
+	}
+
+	newText := " " + legend
+	if prefix == "" {
+		newText = legend + ": "
+	}
+
+	pos := basicLit.Pos() + token.Pos(1+len(prefix))
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "Add the " + legend + " legend to the failure message",
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     pos,
+					End:     pos,
+					NewText: []byte(newText),
+				},
+			},
+		},
+	}
+}
@@ -2,6 +2,7 @@ package checks
 
 import (
 	"go/ast"
+	"go/types"
 
 	"golang.org/x/tools/go/analysis"
 
@@ -22,67 +23,57 @@ func NewEqualityComparison() EqualityComparison {
 
 //nolint:gocritic // still under development
 func (c EqualityComparison) Check(pass *analysis.Pass, testFunc model.TestFunction) {
-	reflectImportName, ok := testFunc.ImportGroup().ReflectImportName()
-	if !ok {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
 		return
 	}
 
-	blStmt := testFunc.GetActualTestBlockStmt()
-
-	var stmts []ast.Stmt
-	if blStmt != nil {
-		stmts = blStmt.List
-	}
-
-	for _, stmt := range stmts {
-		switch node := stmt.(type) {
-		case *ast.IfStmt:
-			// check reflect.DeepEqual calls
-			diag := c.checkCond(node.Cond, reflectImportName)
-			if diag != nil {
-				pass.Report(*diag)
+	for _, blStmt := range testFunc.ComparisonBlockStmts() {
+		for _, stmt := range blStmt.List {
+			switch node := stmt.(type) {
+			case *ast.IfStmt:
+				// check reflect.DeepEqual calls
+				diag := c.checkCond(pass.TypesInfo, node.Cond)
+				if diag != nil {
+					pass.Report(*diag)
+				}
 			}
 		}
 	}
 }
 
-func (c EqualityComparison) checkCond(cond ast.Expr, reflectImportName string) *analysis.Diagnostic {
+func (c EqualityComparison) checkCond(info *types.Info, cond ast.Expr) *analysis.Diagnostic {
 	switch node := cond.(type) {
 	case *ast.CallExpr:
-		return c.checkCallExpr(node, reflectImportName)
+		return c.checkCallExpr(info, node)
 	case *ast.UnaryExpr:
-		return c.checkUnaryExpr(node, reflectImportName)
+		return c.checkUnaryExpr(info, node)
 	}
 
 	return nil
 }
 
 //nolint:gocritic // still under development
-func (c EqualityComparison) checkUnaryExpr(unary *ast.UnaryExpr, reflectImportName string) *analysis.Diagnostic {
+func (c EqualityComparison) checkUnaryExpr(info *types.Info, unary *ast.UnaryExpr) *analysis.Diagnostic {
 	switch node := unary.X.(type) {
 	case *ast.CallExpr:
 		// check reflect.DeepEqual
-		return c.checkCallExpr(node, reflectImportName)
+		return c.checkCallExpr(info, node)
 	}
 
 	return nil
 }
 
-//nolint:gocritic // still under development
-func (c EqualityComparison) checkCallExpr(call *ast.CallExpr, reflectImportName string) *analysis.Diagnostic {
-	switch node := call.Fun.(type) {
-	case *ast.SelectorExpr:
-		if ident, ok := node.X.(*ast.Ident); ok && ident.Name == reflectImportName && node.Sel.Name == "DeepEqual" {
-			return &analysis.Diagnostic{
-				Pos:      node.Pos(),
-				End:      node.End(),
-				Category: c.category,
-				Message:  "Use cmp.Equal or cmp.Diff for equality comparison",
-
-				URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#equality-comparison-and-diffs",
-			}
-		}
+func (c EqualityComparison) checkCallExpr(info *types.Info, call *ast.CallExpr) *analysis.Diagnostic {
+	if !model.IsReflectDeepEqual(info, call) {
+		return nil
 	}
 
-	return nil
+	return &analysis.Diagnostic{
+		Pos:      call.Fun.Pos(),
+		End:      call.Fun.End(),
+		Category: c.category,
+		Message:  "Use cmp.Equal or cmp.Diff for equality comparison",
+
+		URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#equality-comparison-and-diffs",
+	}
 }
@@ -0,0 +1,103 @@
+package checks
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// ErrorSemantics check that the errors are not compared by their messages, or with reflect.DeepEqual or cmp.Equal,
+// since the error semantics are better tested with errors.Is, errors.As or cmpopts.EquateErrors().
+type ErrorSemantics struct {
+	category string
+}
+
+// NewErrorSemantics creates a new ErrorSemantics.
+func NewErrorSemantics() ErrorSemantics {
+	return ErrorSemantics{
+		category: "Test Error Semantics",
+	}
+}
+
+// Check checks that the test does not compare error messages, like in `err.Error() != "..."`,
+// `strings.Contains(err.Error(), "...")` or `got.Error() == want.Error()`, and that two errors are not compared with
+// reflect.DeepEqual or cmp.Equal.
+func (c ErrorSemantics) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	// the fuzz callbacks are inspected as part of their fuzz target.
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzKind, model.HelperKind) {
+		return
+	}
+
+	// the body contains all the comparison blocks, like the subtests of a table-driven test, and the comparisons
+	// outside them, like the ones before the loop.
+	blStmt := testFunc.GetBody()
+	if blStmt == nil {
+		return
+	}
+
+	ast.Inspect(blStmt, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BinaryExpr:
+			if node.Op != token.EQL && node.Op != token.NEQ {
+				return true
+			}
+
+			if model.IsErrorMessage(pass.TypesInfo, node.X) || model.IsErrorMessage(pass.TypesInfo, node.Y) {
+				c.reportErrorMessage(pass, node)
+
+				return false
+			}
+		case *ast.CallExpr:
+			if model.IsStringsMatch(pass.TypesInfo, node) && anyErrorMessage(pass.TypesInfo, node.Args) {
+				c.reportErrorMessage(pass, node)
+
+				return false
+			}
+
+			if len(node.Args) == 2 &&
+				(model.IsReflectDeepEqual(pass.TypesInfo, node) || model.IsGoCmpEqual(pass.TypesInfo, node)) &&
+				model.IsError(pass.TypesInfo, node.Args[0]) && model.IsError(pass.TypesInfo, node.Args[1]) {
+				diag := analysis.Diagnostic{
+					Pos:      node.Pos(),
+					End:      node.End(),
+					Category: c.category,
+					Message: "Errors should not be compared with reflect.DeepEqual or cmp.Equal, " +
+						"use errors.Is, errors.As or cmp.Equal with cmpopts.EquateErrors()",
+					URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#test-error-semantics",
+				}
+				pass.Report(diag)
+
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+func (c ErrorSemantics) reportErrorMessage(pass *analysis.Pass, node ast.Node) {
+	diag := analysis.Diagnostic{
+		Pos:      node.Pos(),
+		End:      node.End(),
+		Category: c.category,
+		Message: "Error messages should not be compared as strings, " +
+			"use errors.Is, errors.As or cmp.Equal with cmpopts.EquateErrors()",
+		URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#test-error-semantics",
+	}
+	pass.Report(diag)
+}
+
+// anyErrorMessage returns whether any of the expressions gets the message of an error.
+func anyErrorMessage(info *types.Info, exprs []ast.Expr) bool {
+	for _, expr := range exprs {
+		if model.IsErrorMessage(info, expr) {
+			return true
+		}
+	}
+
+	return false
+}
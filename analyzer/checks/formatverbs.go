@@ -0,0 +1,87 @@
+package checks
+
+import (
+	"strconv"
+	"strings"
+)
+
+// formatVerb is a verb found in a failure message, like %v or %+q.
+type formatVerb struct {
+	// start position of the verb (the '%') in the failure message.
+	start int
+	// end position (exclusive) of the verb in the failure message.
+	end int
+	// argIndex index of the t.Errorf argument (after the format string) consumed by this verb.
+	argIndex int
+}
+
+// parseFormatVerbs returns the verbs found in the failure message, in order.
+// Escaped percent signs (%%) are skipped, and the explicit argument indexes (%[2]v) and
+// the arguments consumed by '*' width and precision are taken into account.
+func parseFormatVerbs(failureMessage string) []formatVerb {
+	verbs := make([]formatVerb, 0)
+	argIndex := 0
+
+	for i := 0; i < len(failureMessage); i++ {
+		if failureMessage[i] != '%' {
+			continue
+		}
+
+		start := i
+		i++
+
+		// flags
+		for i < len(failureMessage) && strings.ContainsRune("+-# 0", rune(failureMessage[i])) {
+			i++
+		}
+
+		// width and precision, they may contain explicit indexes and '*'
+		for i < len(failureMessage) && strings.ContainsRune("0123456789.*[]", rune(failureMessage[i])) {
+			switch failureMessage[i] {
+			case '[':
+				closing := strings.IndexByte(failureMessage[i:], ']')
+				if closing == -1 {
+					break
+				}
+
+				if n, err := strconv.Atoi(failureMessage[i+1 : i+closing]); err == nil {
+					argIndex = n - 1
+				}
+
+				i += closing
+			case '*':
+				argIndex++
+			}
+
+			i++
+		}
+
+		if i >= len(failureMessage) {
+			break
+		}
+
+		if failureMessage[i] == '%' {
+			continue
+		}
+
+		verbs = append(verbs, formatVerb{
+			start:    start,
+			end:      i + 1,
+			argIndex: argIndex,
+		})
+		argIndex++
+	}
+
+	return verbs
+}
+
+// unquoteFailureMessage returns the content of the failure message string literal.
+func unquoteFailureMessage(failureMessage string) string {
+	unquoted, err := strconv.Unquote(failureMessage)
+	if err != nil {
+		// It's not a string literal that can be unquoted, we'll use the content as is.
+		return failureMessage
+	}
+
+	return unquoted
+}
@@ -0,0 +1,66 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFormatVerbs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		failureMessage string
+		want           []formatVerb
+	}{
+		"no verbs": {
+			failureMessage: "something failed",
+			want:           []formatVerb{},
+		},
+		"got want": {
+			failureMessage: "YourFunc(%v) = %v, want %v",
+			want: []formatVerb{
+				{start: 9, end: 11, argIndex: 0},
+				{start: 15, end: 17, argIndex: 1},
+				{start: 24, end: 26, argIndex: 2},
+			},
+		},
+		"escaped percent": {
+			failureMessage: "100%% = %d",
+			want: []formatVerb{
+				{start: 8, end: 10, argIndex: 0},
+			},
+		},
+		"flags and precision": {
+			failureMessage: "%+v, %-8.2f",
+			want: []formatVerb{
+				{start: 0, end: 3, argIndex: 0},
+				{start: 5, end: 11, argIndex: 1},
+			},
+		},
+		"explicit argument index": {
+			failureMessage: "%[2]v %[1]v",
+			want: []formatVerb{
+				{start: 0, end: 5, argIndex: 1},
+				{start: 6, end: 11, argIndex: 0},
+			},
+		},
+		"star width": {
+			failureMessage: "%*d %v",
+			want: []formatVerb{
+				{start: 0, end: 3, argIndex: 1},
+				{start: 4, end: 6, argIndex: 2},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := parseFormatVerbs(tc.failureMessage)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(formatVerb{})); diff != "" {
+				t.Errorf("parseFormatVerbs(%q) mismatch (-want +got):\n%s", tc.failureMessage, diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package checks
 
 import (
 	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 
@@ -24,6 +27,10 @@ func NewGotBeforeWant() GotBeforeWant {
 // Check test outputs should output the actual value that the function returned before printing
 // the value that was expected.
 func (c GotBeforeWant) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
 	for _, testBlock := range testFunc.TestPartBlocks() {
 		ifComparing, ok := testBlock.IfComparing().(model.ComparingParamsIfStmt)
 		if !ok {
@@ -32,24 +39,27 @@ func (c GotBeforeWant) Check(pass *analysis.Pass, testFunc model.TestFunction) {
 
 		var gotIndex, wantIndex int
 
+		gotFound, wantFound := false, false
+
 		got := ifComparing.Got()
 		want := ifComparing.Want()
 
 		for i, arg := range testBlock.TErrorCallExpr().GetArgs() {
-			if ident, isIdent := arg.(*ast.Ident); isIdent && ident.Name == got.Name {
-				gotIndex = i
+			if isSameVariable(got, arg) {
+				gotIndex, gotFound = i, true
 
 				continue
 			}
 
 			if isSameVariable(want, arg) {
-				wantIndex = i
+				wantIndex, wantFound = i, true
 
 				continue
 			}
 		}
 
-		if gotIndex < wantIndex {
+		// both values must be printed to know the order, e.g. the want value can be a literal.
+		if !gotFound || !wantFound || gotIndex < wantIndex {
 			continue
 		}
 
@@ -61,11 +71,202 @@ func (c GotBeforeWant) Check(pass *analysis.Pass, testFunc model.TestFunction) {
 				"printing the value that was expected",
 			URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#got-before-want",
 		}
+
+		if testBlock.TErrorCallExpr().Kind().Formatted {
+			diag.SuggestedFixes = c.suggestedFixes(pass, testBlock.TErrorCallExpr(), gotIndex, wantIndex)
+		}
+
 		pass.Report(diag)
 	}
+
+	for _, helperCallBlock := range testFunc.HelperCallBlocks(helperFactOf(pass)) {
+		c.checkHelperCall(pass, helperCallBlock)
+	}
+}
+
+// checkHelperCall checks that the actual value that the function returned is passed as the got parameter of the
+// helper, and not as the want parameter, e.g. `AssertEqual(t, got, want)` instead of `AssertEqual(t, want, got)`.
+func (c GotBeforeWant) checkHelperCall(pass *analysis.Pass, helperCallBlock model.HelperCallBlock) {
+	gotArg, wantArg := helperCallBlock.GotArg(), helperCallBlock.WantArg()
+
+	testedFunc := helperCallBlock.TestedFunc()
+	if !isTestedFuncResult(testedFunc, wantArg) || isTestedFuncResult(testedFunc, gotArg) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      helperCallBlock.CallExpr().Pos(),
+		End:      helperCallBlock.CallExpr().End(),
+		Category: c.category,
+		Message:  "The actual value that the function returned should be passed as the got parameter of the helper",
+		URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#got-before-want",
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message: "Swap the got and want arguments",
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     wantArg.Pos(),
+						End:     wantArg.End(),
+						NewText: []byte(nodeText(pass.Fset, gotArg)),
+					},
+					{
+						Pos:     gotArg.Pos(),
+						End:     gotArg.End(),
+						NewText: []byte(nodeText(pass.Fset, wantArg)),
+					},
+				},
+			},
+		},
+	}
+	pass.Report(diag)
+}
+
+// isTestedFuncResult returns whether the expression reads any of the values returned by the tested function.
+func isTestedFuncResult(testedFunc model.TestedCallExpr, expr ast.Expr) bool {
+	for _, param := range testedFunc.Params() {
+		if isSameVariable(param, expr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suggestedFixes returns a fix that prints got before want when the failure message is a simple literal, with
+// each verb matching one argument, and the want and got verbs are labelled, like in `want %v, got %v`.
+// The labelled segment is rewritten to the canonical `= %v, want %v` form, and the arguments are swapped.
+func (c GotBeforeWant) suggestedFixes(
+	pass *analysis.Pass,
+	tErrorfCallExpr model.TErrorfCallExpr,
+	gotIndex, wantIndex int,
+) []analysis.SuggestedFix {
+	basicLit, ok := tErrorfCallExpr.CallExpr().Args[0].(*ast.BasicLit)
+	if !ok || basicLit.Kind != token.STRING {
+		return nil
+	}
+
+	args := tErrorfCallExpr.GetArgs()
+
+	// work with the literal as it's written in the source code, so interpreted and raw strings are supported.
+	literal := basicLit.Value
+
+	verbs := parseFormatVerbs(literal)
+	if len(verbs) != len(args) {
+		return nil
+	}
+
+	for i, verb := range verbs {
+		if verb.argIndex != i {
+			return nil
+		}
+	}
+
+	newLiteral, ok := gotBeforeWantFailureMessage(literal, verbs[wantIndex], verbs[gotIndex])
+	if !ok {
+		return nil
+	}
+
+	gotArg, wantArg := args[gotIndex], args[wantIndex]
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "Print got before want",
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     basicLit.Pos(),
+					End:     basicLit.End(),
+					NewText: []byte(newLiteral),
+				},
+				{
+					Pos:     wantArg.Pos(),
+					End:     wantArg.End(),
+					NewText: []byte(nodeText(pass.Fset, gotArg)),
+				},
+				{
+					Pos:     gotArg.Pos(),
+					End:     gotArg.End(),
+					NewText: []byte(nodeText(pass.Fset, wantArg)),
+				},
+			},
+		},
+	}
 }
 
+var (
+	wantLabelRegexp = regexp.MustCompile(`(?i)\b(want(ed)?|expect(ed)?|exp)\s*[:=]?\s*$`)
+	gotLabelRegexp  = regexp.MustCompile(`(?i)^\s*[,;]?\s*(but\s+)?(got|actual|result)\s*[:=]?\s*$`)
+)
+
+// gotBeforeWantFailureMessage rewrites the literal `<prefix> want <wantVerb>, got <gotVerb><suffix>` into
+// `<prefix> = <gotVerb>, want <wantVerb><suffix>`, or `got <gotVerb>, want <wantVerb><suffix>` if there is no prefix.
+func gotBeforeWantFailureMessage(literal string, wantVerb, gotVerb formatVerb) (string, bool) {
+	// the want verb must be printed right before the got verb
+	if wantVerb.end > gotVerb.start {
+		return "", false
+	}
+
+	wantLabel := wantLabelRegexp.FindStringIndex(literal[1:wantVerb.start])
+	if wantLabel == nil {
+		return "", false
+	}
+
+	if !gotLabelRegexp.MatchString(literal[wantVerb.end:gotVerb.start]) {
+		return "", false
+	}
+
+	// the first character of the literal is the opening quote
+	start := wantLabel[0] + 1
+	prefix := strings.TrimRight(literal[:start], " :,-")
+	gotText := literal[gotVerb.start:gotVerb.end]
+	wantText := literal[wantVerb.start:wantVerb.end]
+
+	var segment string
+	if len(prefix) == 1 {
+		segment = "got " + gotText + ", want " + wantText
+	} else {
+		segment = " = " + gotText + ", want " + wantText
+	}
+
+	return prefix + segment + literal[gotVerb.end:], true
+}
+
+// isSameVariable returns whether the expression b is the variable a, or an expression that reads it, like
+// (a), *a, &a, a[i], a[i:j], a.Field, len(a) or a.String().
 func isSameVariable(a, b ast.Expr) bool {
+	if isVariable(a, b) {
+		return true
+	}
+
+	switch nodeB := b.(type) {
+	case *ast.ParenExpr:
+		return isSameVariable(a, nodeB.X)
+	case *ast.StarExpr:
+		return isSameVariable(a, nodeB.X)
+	case *ast.UnaryExpr:
+		return isSameVariable(a, nodeB.X)
+	case *ast.IndexExpr:
+		return isSameVariable(a, nodeB.X)
+	case *ast.SliceExpr:
+		return isSameVariable(a, nodeB.X)
+	case *ast.SelectorExpr:
+		return isSameVariable(a, nodeB.X)
+	case *ast.CallExpr:
+		if isSameVariable(a, nodeB.Fun) {
+			return true
+		}
+
+		for _, arg := range nodeB.Args {
+			if isSameVariable(a, arg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isVariable returns whether the expression b is exactly the variable a, either an identifier or a field.
+func isVariable(a, b ast.Expr) bool {
 	switch nodeA := a.(type) {
 	case *ast.Ident:
 		if nodeB, isIdent := b.(*ast.Ident); isIdent && nodeA.Name == nodeB.Name {
@@ -77,7 +278,7 @@ func isSameVariable(a, b ast.Expr) bool {
 				return false
 			}
 
-			return isSameVariable(nodeA.X, nodeB.X)
+			return isVariable(nodeA.X, nodeB.X)
 		}
 	}
 
@@ -1,8 +1,9 @@
 package checks
 
 import (
+	"go/ast"
+	"go/token"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -24,32 +25,125 @@ func NewIdentifyFunction() IdentifyFunction {
 
 // Check checks that the failure messages in t.Errorf/Fatalf follow the format expected.
 func (c IdentifyFunction) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
 	for _, testBlock := range testFunc.TestPartBlocks() {
 		if containsFunctionName(testBlock) {
 			continue
 		}
 
 		diag := analysis.Diagnostic{
-			Pos:      testBlock.TErrorCallExpr().CallExpr().Pos(),
-			End:      testBlock.TErrorCallExpr().CallExpr().End(),
-			Category: c.category,
-			Message:  "Failure messages should include the name of the function that failed",
-			URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#identify-the-function",
+			Pos:            testBlock.TErrorCallExpr().CallExpr().Pos(),
+			End:            testBlock.TErrorCallExpr().CallExpr().End(),
+			Category:       c.category,
+			Message:        "Failure messages should include the name of the function that failed",
+			URL:            "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#identify-the-function",
+			SuggestedFixes: c.suggestedFixes(pass, testBlock),
 		}
 		pass.Report(diag)
 	}
+
+	for _, helperCallBlock := range testFunc.HelperCallBlocks(helperFactOf(pass)) {
+		c.checkHelperCall(pass, helperCallBlock)
+	}
 }
 
-// containsFunctionName returns whether the failure message contains the function name.
-func containsFunctionName(t model.TestPartBlock) bool {
-	currentFailureMessage := t.TErrorCallExpr().FailureMessage()
+// checkHelperCall checks that the failure message passed to the helper contains the function name,
+// if the helper accepts a failure message.
+func (c IdentifyFunction) checkHelperCall(pass *analysis.Pass, helperCallBlock model.HelperCallBlock) {
+	if helperCallBlock.Fact().MessageIndex < 0 {
+		return
+	}
+
+	functionName := helperCallBlock.TestedFunc().FunctionName()
+
+	if messageArg, ok := helperCallBlock.MessageArg(); ok {
+		basicLit, isBasicLit := messageArg.(*ast.BasicLit)
+		if !isBasicLit || basicLit.Kind != token.STRING ||
+			containsFunctionNameString(functionName, unquoteFailureMessage(basicLit.Value)) {
+			return
+		}
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      helperCallBlock.CallExpr().Pos(),
+		End:      helperCallBlock.CallExpr().End(),
+		Category: c.category,
+		Message:  "Failure messages should include the name of the function that failed",
+		URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#identify-the-function",
+	}
+	pass.Report(diag)
+}
+
+var gotLabelPrefixRegexp = regexp.MustCompile(`^(?i)got\s*[:=]?\s*`)
+
+// suggestedFixes returns a fix that adds the function name and its inputs to the failure message.
+// `got %v, want %v` is rewritten into `YourFunc(%v) = %v, want %v`, and any other message is prefixed
+// with `YourFunc(%v): `. The inputs of the tested function are added as the first arguments, so the fix is not
+// offered if any of them can't be evaluated again, like a call.
+func (c IdentifyFunction) suggestedFixes(pass *analysis.Pass, t model.TestPartBlock) []analysis.SuggestedFix {
+	functionName := t.TestedFunc().FunctionName()
+	if functionName == "" || !t.TErrorCallExpr().Kind().Formatted {
+		return nil
+	}
+
+	basicLit, ok := t.TErrorCallExpr().CallExpr().Args[0].(*ast.BasicLit)
+	if !ok || basicLit.Kind != token.STRING {
+		return nil
+	}
+
+	verbs, inputsText, ok := testedCallInputs(pass.Fset, t.TestedFunc())
+	if !ok {
+		return nil
+	}
+
+	call := functionName + "(" + verbs + ")"
+
+	// work with the literal as it's written in the source code, so interpreted and raw strings are supported.
+	quote, content := basicLit.Value[:1], basicLit.Value[1:len(basicLit.Value)-1]
+
+	// the inputs are added before the other arguments, so the explicit argument indexes, like %[1]v, would be wrong.
+	for _, verb := range parseFormatVerbs(content) {
+		if strings.Contains(content[verb.start:verb.end], "[") {
+			return nil
+		}
+	}
+
+	if label := gotLabelPrefixRegexp.FindString(content); label != "" {
+		content = call + " = " + content[len(label):]
+	} else {
+		content = call + ": " + content
+	}
+
+	edits := []analysis.TextEdit{
+		{
+			Pos:     basicLit.Pos(),
+			End:     basicLit.End(),
+			NewText: []byte(quote + content + quote),
+		},
+	}
+
+	if len(inputsText) > 0 {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     basicLit.End(),
+			End:     basicLit.End(),
+			NewText: []byte(", " + strings.Join(inputsText, ", ")),
+		})
+	}
 
-	unquoted, err := strconv.Unquote(currentFailureMessage)
-	if err != nil {
-		// It's not a string literal that can be unquoted, maybe a raw string literal.
-		// We'll check the content as is.
-		unquoted = currentFailureMessage
+	return []analysis.SuggestedFix{
+		{
+			Message:   "Add the function name to the failure message",
+			TextEdits: edits,
+		},
 	}
+}
+
+// containsFunctionName returns whether the failure message contains the function name.
+func containsFunctionName(t model.TestPartBlock) bool {
+	unquoted := unquoteFailureMessage(t.TErrorCallExpr().FailureMessage())
 
 	funName := t.TestedFunc().FunctionName()
 
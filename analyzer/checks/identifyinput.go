@@ -0,0 +1,146 @@
+package checks
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// IdentifyInput check that the failure messages in t.Errorf/Fatalf contains the inputs of the tested function.
+type IdentifyInput struct {
+	category string
+}
+
+// NewIdentifyInput creates a new IdentifyInput.
+func NewIdentifyInput() IdentifyInput {
+	return IdentifyInput{
+		category: "Identify The Input",
+	}
+}
+
+// Check checks that the inputs of the tested function are printed inside the parenthesis of the function name,
+// like in `YourFunc(%v) = %v, want %v`. The tested functions without a name, like `fns[i](in)`, are skipped, since
+// their parenthesis can't be found.
+func (c IdentifyInput) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
+	for _, testBlock := range testFunc.TestPartBlocks() {
+		if testBlock.TestedFunc().FunctionName() == "" || containsInputs(testBlock) {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      testBlock.TErrorCallExpr().CallExpr().Pos(),
+			End:      testBlock.TErrorCallExpr().CallExpr().End(),
+			Category: c.category,
+			Message:  "Failure messages should include the inputs of the function that failed",
+			URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#identify-the-input",
+		}
+		pass.Report(diag)
+	}
+}
+
+// containsInputs returns whether the non-trivial inputs of the tested function are printed in the
+// failure message, inside the parenthesis that follows the function name.
+func containsInputs(t model.TestPartBlock) bool {
+	inputs := nonTrivialInputs(t.TestedFunc().CallExpr().Args)
+	if len(inputs) == 0 {
+		return true
+	}
+
+	// the values printed by the non formatted reporters can't be matched with the message,
+	// so it's enough if they are printed.
+	printedArgs := t.TErrorCallExpr().GetArgs()
+
+	if t.TErrorCallExpr().Kind().Formatted {
+		failureMessage := unquoteFailureMessage(t.TErrorCallExpr().FailureMessage())
+
+		var ok bool
+
+		printedArgs, ok = argsInsideFunctionParenthesis(t.TestedFunc().FunctionName(), failureMessage, printedArgs)
+		if !ok {
+			return false
+		}
+	}
+
+	for _, input := range inputs {
+		found := false
+
+		for _, arg := range printedArgs {
+			if isSameVariable(input, arg) {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nonTrivialInputs returns the arguments of the tested function that are worth identifying in the failure message,
+// this is, variables (`in`) and fields (`tc.in`), but not literals.
+func nonTrivialInputs(args []ast.Expr) []ast.Expr {
+	inputs := make([]ast.Expr, 0, len(args))
+
+	for _, arg := range args {
+		switch node := arg.(type) {
+		case *ast.Ident:
+			if node.Name == "_" || node.Name == "nil" || node.Name == "true" || node.Name == "false" {
+				continue
+			}
+
+			inputs = append(inputs, node)
+		case *ast.SelectorExpr:
+			inputs = append(inputs, node)
+		}
+	}
+
+	return inputs
+}
+
+// argsInsideFunctionParenthesis returns the t.Errorf arguments printed by the verbs found between the parenthesis
+// that follows the function name in the failure message, e.g. `in` in `YourFunc(%v) = %v, want %v`.
+func argsInsideFunctionParenthesis(functionName, failureMessage string, args []ast.Expr) ([]ast.Expr, bool) {
+	parts := strings.Split(functionName, ".")
+	lastFunctionName := parts[len(parts)-1]
+
+	index := strings.Index(failureMessage, lastFunctionName+"(")
+	if index == -1 {
+		return nil, false
+	}
+
+	opening := index + len(lastFunctionName)
+
+	closing := strings.IndexByte(failureMessage[opening:], ')')
+	if closing == -1 {
+		return nil, false
+	}
+
+	closing += opening
+
+	printedArgs := make([]ast.Expr, 0)
+
+	for _, verb := range parseFormatVerbs(failureMessage) {
+		if verb.start < opening || verb.end > closing {
+			continue
+		}
+
+		if verb.argIndex < 0 || verb.argIndex >= len(args) {
+			continue
+		}
+
+		printedArgs = append(printedArgs, args[verb.argIndex])
+	}
+
+	return printedArgs, true
+}
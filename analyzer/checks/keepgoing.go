@@ -0,0 +1,212 @@
+package checks
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// KeepGoing check that the failed comparisons are reported with t.Error, so the test keeps going and reports
+// all the failures in a single run.
+type KeepGoing struct {
+	category string
+}
+
+// NewKeepGoing creates a new KeepGoing.
+func NewKeepGoing() KeepGoing {
+	return KeepGoing{
+		category: "Keep Going",
+	}
+}
+
+//nolint:gochecknoglobals // read-only lookup table
+var nonFatalMethods = map[string]string{
+	"Fatalf":  "Errorf",
+	"Fatal":   "Error",
+	"FailNow": "Fail",
+}
+
+// Check checks that t.Fatal or t.Fatalf are not used in a comparison whose compared values are not used afterward,
+// and that inside the t.Run body of a table-driven test t.Error and return are used instead.
+// Setup failures, like `err != nil`, are not comparisons, so they are allowed to stop the test.
+func (c KeepGoing) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	// benchmarks are not about correctness and helpers can't know whether the caller can keep going.
+	if !testFunc.IsKind(model.TestKind, model.FuzzCallbackKind) {
+		return
+	}
+
+	isSubtest := testFunc.GetTableDrivenInfo() != nil && testFunc.GetTableDrivenInfo().Subtests
+
+	for _, testBlock := range testFunc.TestPartBlocks() {
+		if !testBlock.TErrorCallExpr().Kind().Fatal {
+			continue
+		}
+
+		ifStmt := testBlock.IfComparing().IfStmt()
+
+		blStmt := testFunc.ComparisonBlockStmtOf(ifStmt)
+		if blStmt == nil {
+			continue
+		}
+
+		dependent := laterStmtsUseComparedValues(testFunc.TypesInfo(), blStmt.List, ifStmt, testBlock.TestedFunc())
+
+		if dependent && !isSubtest {
+			continue
+		}
+
+		message := "Prefer t.Error over t.Fatal so the test keeps going and reports all the failures"
+		if dependent {
+			message = "Prefer t.Error and return over t.Fatal inside the t.Run body, returning from the subtest is enough"
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:            testBlock.TErrorCallExpr().CallExpr().Pos(),
+			End:            testBlock.TErrorCallExpr().CallExpr().End(),
+			Category:       c.category,
+			Message:        message,
+			URL:            "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#keep-going",
+			SuggestedFixes: c.suggestedFixes(pass, testBlock.TErrorCallExpr(), ifStmt, dependent),
+		}
+		pass.Report(diag)
+	}
+}
+
+// suggestedFixes returns a fix that replaces t.Fatalf, t.Fatal or t.FailNow by t.Errorf, t.Error or t.Fail,
+// adding a return after it if the rest of the subtest depends on the compared values.
+func (c KeepGoing) suggestedFixes(
+	pass *analysis.Pass,
+	tErrorfCallExpr model.TErrorfCallExpr,
+	ifStmt *ast.IfStmt,
+	addReturn bool,
+) []analysis.SuggestedFix {
+	fatalCallExpr := tErrorfCallExpr.CallExpr()
+	if tErrorfCallExpr.FailCallExpr() != nil {
+		fatalCallExpr = tErrorfCallExpr.FailCallExpr()
+	}
+
+	selectorExpr, ok := fatalCallExpr.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	nonFatalMethod, ok := nonFatalMethods[selectorExpr.Sel.Name]
+	if !ok {
+		return nil
+	}
+
+	textEdits := []analysis.TextEdit{
+		{
+			Pos:     selectorExpr.Sel.Pos(),
+			End:     selectorExpr.Sel.End(),
+			NewText: []byte(nonFatalMethod),
+		},
+	}
+
+	if addReturn && !endsWithReturn(ifStmt.Body) {
+		// the return is added as the last statement of the if body, right before the closing brace.
+		indent := strings.Repeat("\t", pass.Fset.Position(ifStmt.Body.Rbrace).Column-1)
+		textEdits = append(textEdits, analysis.TextEdit{
+			Pos:     ifStmt.Body.Rbrace,
+			End:     ifStmt.Body.Rbrace,
+			NewText: []byte("\treturn\n" + indent),
+		})
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   "Replace t." + selectorExpr.Sel.Name + " by t." + nonFatalMethod,
+			TextEdits: textEdits,
+		},
+	}
+}
+
+// laterStmtsUseComparedValues returns whether the statements after the if statement use the values compared in it.
+func laterStmtsUseComparedValues(
+	info *types.Info,
+	stmts []ast.Stmt,
+	ifStmt *ast.IfStmt,
+	testedFunc model.TestedCallExpr,
+) bool {
+	compared := make(map[types.Object]bool)
+
+	ast.Inspect(ifStmt, func(n ast.Node) bool {
+		if n == ifStmt.Body {
+			return false
+		}
+
+		ident, isIdent := n.(*ast.Ident)
+		if !isIdent {
+			return true
+		}
+
+		for _, param := range testedFunc.Params() {
+			if obj := info.ObjectOf(ident); obj != nil && obj == info.ObjectOf(param) {
+				compared[obj] = true
+			}
+		}
+
+		return true
+	})
+
+	for _, stmt := range stmts {
+		if stmt.Pos() <= ifStmt.End() {
+			continue
+		}
+
+		// the compared values are no longer used once they are reassigned, like in `got = YourFunc(in2)`.
+		if assignStmt, isAssignStmt := stmt.(*ast.AssignStmt); isAssignStmt &&
+			(assignStmt.Tok == token.ASSIGN || assignStmt.Tok == token.DEFINE) {
+			if usesObjects(info, assignStmt.Rhs, compared) {
+				return true
+			}
+
+			for _, lhs := range assignStmt.Lhs {
+				if ident, isIdent := lhs.(*ast.Ident); isIdent {
+					delete(compared, info.ObjectOf(ident))
+				}
+			}
+
+			continue
+		}
+
+		if usesObjects(info, []ast.Node{stmt}, compared) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// usesObjects returns whether any of the nodes uses any of the objects.
+func usesObjects[N ast.Node](info *types.Info, nodes []N, objects map[types.Object]bool) bool {
+	found := false
+
+	for _, node := range nodes {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if ident, isIdent := n.(*ast.Ident); isIdent && objects[info.ObjectOf(ident)] {
+				found = true
+			}
+
+			return !found
+		})
+	}
+
+	return found
+}
+
+// endsWithReturn returns whether the last statement of the block is a return.
+func endsWithReturn(blStmt *ast.BlockStmt) bool {
+	if len(blStmt.List) == 0 {
+		return false
+	}
+
+	_, isReturn := blStmt.List[len(blStmt.List)-1].(*ast.ReturnStmt)
+
+	return isReturn
+}
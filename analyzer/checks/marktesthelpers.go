@@ -0,0 +1,95 @@
+package checks
+
+import (
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// MarkTestHelpers check that the test helpers that report failures call t.Helper().
+type MarkTestHelpers struct {
+	category string
+}
+
+// NewMarkTestHelpers creates a new MarkTestHelpers.
+func NewMarkTestHelpers() MarkTestHelpers {
+	return MarkTestHelpers{
+		category: "Mark Test Helpers",
+	}
+}
+
+// Check checks that the test helper calls t.Helper() as its first statement if it reports failures,
+// so the failures are reported in the line of the test that called the helper.
+func (c MarkTestHelpers) Check(pass *analysis.Pass, testHelper model.TestHelper) {
+	if testHelper.CallsHelper() || !testHelper.ReportsFailures() {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:            testHelper.FuncType().Pos(),
+		End:            testHelper.FuncType().End(),
+		Category:       c.category,
+		Message:        "Test helpers that report failures should call t.Helper() as their first statement",
+		URL:            "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#mark-test-helpers",
+		SuggestedFixes: c.suggestedFixes(pass, testHelper),
+	}
+	pass.Report(diag)
+}
+
+// CheckHelperCalls checks the calls to the test helpers of other packages that don't call t.Helper(), since their
+// failures are reported in the line of the helper, and not in the line of the test that called it. The helpers of the
+// package are reported in their declaration by Check.
+func (c MarkTestHelpers) CheckHelperCalls(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
+	for _, helperCallBlock := range testFunc.HelperCallBlocks(helperFactOf(pass)) {
+		if helperCallBlock.Fact().CallsHelper {
+			continue
+		}
+
+		fn := typeutil.StaticCallee(pass.TypesInfo, helperCallBlock.CallExpr())
+		if fn == nil || fn.Pkg() == nil || fn.Pkg() == pass.Pkg {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      helperCallBlock.CallExpr().Pos(),
+			End:      helperCallBlock.CallExpr().End(),
+			Category: c.category,
+			Message: "Test helper " + fn.Pkg().Name() + "." + fn.Name() + " does not call t.Helper(), " +
+				"so its failures are reported in the helper instead of in this line",
+			URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#mark-test-helpers",
+		}
+		pass.Report(diag)
+	}
+}
+
+// suggestedFixes returns a fix that inserts t.Helper() as the first statement of the test helper.
+func (c MarkTestHelpers) suggestedFixes(pass *analysis.Pass, testHelper model.TestHelper) []analysis.SuggestedFix {
+	body := testHelper.Body()
+	if len(body.List) == 0 {
+		return nil
+	}
+
+	first := body.List[0]
+	indent := strings.Repeat("\t", pass.Fset.Position(first.Pos()).Column-1)
+	helperCall := testHelper.GetTestVar().Name + ".Helper()"
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "Call " + helperCall,
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     first.Pos(),
+					End:     first.Pos(),
+					NewText: []byte(helperCall + "\n\n" + indent),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,252 @@
+package checks
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+const (
+	StructKind PrintDiffsKind = "struct"
+	MapKind    PrintDiffsKind = "map"
+	SliceKind  PrintDiffsKind = "slice"
+	ArrayKind  PrintDiffsKind = "array"
+)
+
+type (
+	// PrintDiffsKind is a kind of composite type whose values should be printed with a diff.
+	PrintDiffsKind string
+
+	// PrintDiffs check that the composite values compared in a test are printed as a diff, and not with %v.
+	PrintDiffs struct {
+		category string
+
+		kinds           map[PrintDiffsKind]bool
+		minStructFields int
+	}
+
+	PrintDiffsKindError struct {
+		requestedKind PrintDiffsKind
+	}
+)
+
+func (e PrintDiffsKindError) Error() string {
+	return fmt.Sprintf("print diffs kind not expected: %q", e.requestedKind)
+}
+
+// NewPrintDiffs creates a new PrintDiffs that reports the values of the kinds, struct, map, slice or array,
+// printed with %v, the structs are only reported if they have at least minStructFields fields.
+func NewPrintDiffs(kinds []PrintDiffsKind, minStructFields int) (PrintDiffs, error) {
+	kindsSet := make(map[PrintDiffsKind]bool, len(kinds))
+
+	for _, kind := range kinds {
+		if kind != StructKind && kind != MapKind && kind != SliceKind && kind != ArrayKind {
+			return PrintDiffs{}, PrintDiffsKindError{requestedKind: kind}
+		}
+
+		kindsSet[kind] = true
+	}
+
+	return PrintDiffs{
+		category:        "Print Diffs",
+		kinds:           kindsSet,
+		minStructFields: minStructFields,
+	}, nil
+}
+
+// Check checks that the got or want values of a comparison, if they are composite values, are not printed
+// with %v or %+v in the failure message, since a diff is easier to read.
+func (c PrintDiffs) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind, model.FuzzCallbackKind, model.HelperKind) {
+		return
+	}
+
+	for _, testBlock := range testFunc.TestPartBlocks() {
+		ifComparing, ok := testBlock.IfComparing().(model.ComparingParamsIfStmt)
+		if !ok || !testBlock.TErrorCallExpr().Kind().Formatted {
+			continue
+		}
+
+		if !c.printsCompositeWithV(pass.TypesInfo, testBlock.TErrorCallExpr(), ifComparing) {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      testBlock.TErrorCallExpr().CallExpr().Pos(),
+			End:      testBlock.TErrorCallExpr().CallExpr().End(),
+			Category: c.category,
+			Message: "Composite values should be printed as a diff, " +
+				"like in `if diff := cmp.Diff(want, got); diff != \"\"`",
+			URL:            "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#print-diffs",
+			SuggestedFixes: c.suggestedFixes(pass, testBlock, ifComparing),
+		}
+		pass.Report(diag)
+	}
+}
+
+// suggestedFixes returns a fix that replaces the comparison by a cmp.Diff comparison that prints the diff:
+//
+//	if diff := cmp.Diff(want, got); diff != "" {
+//		t.Errorf("YourFunc(%v) mismatch (-want +got):\n%s", in, diff)
+//	}
+//
+// The fix is offered only when the if statement has no init or else, its body only reports the failure, diff is not
+// declared yet, the values compared with != are not pointers, and the compared values have no unexported fields,
+// since cmp.Diff panics with them.
+// The go-cmp import is added if the file does not import it yet.
+func (c PrintDiffs) suggestedFixes(
+	pass *analysis.Pass,
+	testBlock model.TestPartBlock,
+	ifComparing model.ComparingParamsIfStmt,
+) []analysis.SuggestedFix {
+	ifStmt := ifComparing.IfStmt()
+	reporter := testBlock.TErrorCallExpr()
+
+	if ifStmt.Init != nil || ifStmt.Else != nil || len(ifStmt.Body.List) != 1 {
+		return nil
+	}
+
+	if exprStmt, isExprStmt := ifStmt.Body.List[0].(*ast.ExprStmt); !isExprStmt || exprStmt.X != reporter.CallExpr() {
+		return nil
+	}
+
+	selectorExpr, ok := reporter.CallExpr().Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	if isNameInScope(pass, ifStmt.Pos(), "diff") {
+		return nil
+	}
+
+	got, want := ifComparing.Got(), ifComparing.Want()
+
+	// got != want compares the pointers, and not the values they point to like cmp.Diff.
+	if _, isPtr := pass.TypesInfo.TypeOf(got).Underlying().(*types.Pointer); isPtr && isNotEqual(ifStmt.Cond) {
+		return nil
+	}
+
+	if hasUnexportedFields(pass.TypesInfo.TypeOf(got), make(map[types.Type]bool)) ||
+		hasUnexportedFields(pass.TypesInfo.TypeOf(want), make(map[types.Type]bool)) {
+		return nil
+	}
+
+	file := fileOf(pass, ifStmt)
+	if file == nil {
+		return nil
+	}
+
+	cmpName, importEdits, ok := goCmpImport(file)
+	if !ok {
+		return nil
+	}
+
+	reporterName := "Errorf"
+	if reporter.Kind().Fatal {
+		reporterName = "Fatalf"
+	}
+
+	failureMessage, failureArgs := `"mismatch (-want +got):\n%s"`, []string{"diff"}
+
+	if testedFunc := testBlock.TestedFunc(); testedFunc.FunctionName() != "" {
+		if verbs, inputsText, ok := testedCallInputs(pass.Fset, testedFunc); ok {
+			failureMessage = `"` + testedFunc.FunctionName() + "(" + verbs + `) mismatch (-want +got):\n%s"`
+			failureArgs = append(inputsText, failureArgs...)
+		}
+	}
+
+	indent := strings.Repeat("\t", pass.Fset.Position(ifStmt.Pos()).Column-1)
+	newText := "if diff := " + cmpName + ".Diff(" + nodeText(pass.Fset, want) + ", " +
+		nodeText(pass.Fset, got) + "); diff != \"\" {\n" +
+		indent + "\t" + nodeText(pass.Fset, selectorExpr.X) + "." + reporterName + "(" + failureMessage + ", " +
+		strings.Join(failureArgs, ", ") + ")\n" +
+		indent + "}"
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "Print the diff returned by cmp.Diff",
+			TextEdits: append([]analysis.TextEdit{
+				{
+					Pos:     ifStmt.Pos(),
+					End:     ifStmt.End(),
+					NewText: []byte(newText),
+				},
+			}, importEdits...),
+		},
+	}
+}
+
+// isNotEqual returns whether the condition is a != comparison.
+func isNotEqual(cond ast.Expr) bool {
+	binaryExpr, isBinaryExpr := cond.(*ast.BinaryExpr)
+
+	return isBinaryExpr && binaryExpr.Op == token.NEQ
+}
+
+// printsCompositeWithV returns whether the got or want values are printed with %v or %+v, and they are
+// composite values of the configured kinds.
+func (c PrintDiffs) printsCompositeWithV(
+	info *types.Info,
+	tErrorfCallExpr model.TErrorfCallExpr,
+	ifComparing model.ComparingParamsIfStmt,
+) bool {
+	failureMessage := unquoteFailureMessage(tErrorfCallExpr.FailureMessage())
+	args := tErrorfCallExpr.GetArgs()
+
+	for _, verb := range parseFormatVerbs(failureMessage) {
+		text := failureMessage[verb.start:verb.end]
+		if !strings.HasSuffix(text, "v") || strings.Contains(text, "#") {
+			continue
+		}
+
+		if verb.argIndex < 0 || verb.argIndex >= len(args) {
+			continue
+		}
+
+		arg := args[verb.argIndex]
+		if !isVariable(ifComparing.Got(), arg) && !isVariable(ifComparing.Want(), arg) {
+			continue
+		}
+
+		if c.isReportedKind(info.TypeOf(arg)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isReportedKind returns whether the type, or the type it points to, is of any of the configured kinds.
+func (c PrintDiffs) isReportedKind(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if ptr, isPtr := t.Underlying().(*types.Pointer); isPtr {
+		t = ptr.Elem()
+	}
+
+	switch underlying := t.Underlying().(type) {
+	case *types.Struct:
+		return c.kinds[StructKind] && underlying.NumFields() >= c.minStructFields
+	case *types.Map:
+		return c.kinds[MapKind]
+	case *types.Slice:
+		// []byte is usually printed as a string
+		if basic, isBasic := underlying.Elem().Underlying().(*types.Basic); isBasic && basic.Kind() == types.Byte {
+			return false
+		}
+
+		return c.kinds[SliceKind]
+	case *types.Array:
+		return c.kinds[ArrayKind]
+	}
+
+	return false
+}
@@ -0,0 +1,225 @@
+package checks
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// SubtestNames check that the names of the subtests of a table-driven test are human-readable, unique and can be
+// used with go test -run.
+type SubtestNames struct {
+	category string
+
+	// checkedSharedTables contains the shared tables already checked, so they are reported once.
+	checkedSharedTables map[*ast.CompositeLit]bool
+}
+
+// NewSubtestNames creates a new SubtestNames.
+func NewSubtestNames() SubtestNames {
+	return SubtestNames{
+		category:            "Subtest Names",
+		checkedSharedTables: make(map[*ast.CompositeLit]bool),
+	}
+}
+
+var onlyNumbersRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+// Check checks the names of the entries of the table, either the map keys or the field used as the t.Run name.
+// The names must be unique, not empty, without '/' or leading or trailing spaces, not rewritten by go test
+// and not only numbers. The tables shared by several tests are checked only for the first of them.
+func (c SubtestNames) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind) {
+		return
+	}
+
+	info := testFunc.GetTableDrivenInfo()
+	if info == nil || info.Run == nil || info.Table == nil {
+		return
+	}
+
+	if info.IsShared() {
+		if c.checkedSharedTables[info.Table] {
+			return
+		}
+
+		c.checkedSharedTables[info.Table] = true
+	}
+
+	seen := make(map[string]bool)
+
+	for _, elt := range info.Table.Elts {
+		name, ok := subtestName(pass.TypesInfo, info, elt)
+		if !ok {
+			continue
+		}
+
+		// go test rewrites the names, so "a b" and "a_b" are the same subtest.
+		rewritten := rewriteSubtestName(name)
+		message := subtestNameProblem(name, seen[rewritten])
+		seen[rewritten] = true
+
+		if message == "" {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      elt.Pos(),
+			End:      elt.End(),
+			Category: c.category,
+			Message:  message,
+			URL:      "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#subtest-names",
+		}
+		pass.Report(diag)
+	}
+}
+
+// subtestNameProblem returns the message that describes the problem with the subtest name, or empty if the name is
+// valid.
+func subtestNameProblem(name string, duplicated bool) string {
+	switch {
+	case name == "":
+		return "Subtest names should not be empty"
+	case duplicated:
+		return fmt.Sprintf("Subtest name %q is duplicated", name)
+	case strings.Contains(name, "/"):
+		return fmt.Sprintf("Subtest name %q should not contain '/', since it separates the subtests in go test -run",
+			name)
+	case strings.TrimSpace(name) != name:
+		return fmt.Sprintf("Subtest name %q should not have leading or trailing spaces", name)
+	case rewriteSubtestName(name) != strings.ReplaceAll(name, " ", "_"):
+		return fmt.Sprintf("Subtest name %q is rewritten by go test as %q", name, rewriteSubtestName(name))
+	case onlyNumbersRegexp.MatchString(name):
+		return fmt.Sprintf("Subtest name %q should be human-readable, not only a number", name)
+	default:
+		return ""
+	}
+}
+
+// rewriteSubtestName returns the name as go test rewrites it, the spaces are replaced by '_' and the
+// non-printable characters are escaped.
+func rewriteSubtestName(name string) string {
+	var b strings.Builder
+
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r):
+			b.WriteRune('_')
+		case !strconv.IsPrint(r):
+			s := strconv.QuoteRune(r)
+			b.WriteString(s[1 : len(s)-1])
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// subtestName returns the name of the subtest of the table entry, either the map key, or the value of the field
+// passed to t.Run. It returns false if the name is not a constant or can't be found.
+func subtestName(info *types.Info, tableDrivenInfo *model.TableDrivenInfo, elt ast.Expr) (string, bool) {
+	nameArg := tableDrivenInfo.Run.Args[0]
+
+	switch tableDrivenInfo.FormatType {
+	case "map":
+		kv, isKeyValue := elt.(*ast.KeyValueExpr)
+		if !isKeyValue || !isRangeVar(info, tableDrivenInfo, tableDrivenInfo.Range.Key, nameArg) {
+			return "", false
+		}
+
+		return stringConstant(info, kv.Key)
+	case "slice":
+		selectorExpr, isSelectorExpr := nameArg.(*ast.SelectorExpr)
+		if !isSelectorExpr || !isRangeVar(info, tableDrivenInfo, tableDrivenInfo.Range.Value, selectorExpr.X) {
+			return "", false
+		}
+
+		return fieldStringConstant(info, elt, selectorExpr.Sel.Name)
+	default:
+		return "", false
+	}
+}
+
+// isRangeVar returns whether the expression is the range variable, or a rebinding of it, like tc := tc.
+func isRangeVar(info *types.Info, tableDrivenInfo *model.TableDrivenInfo, rangeVar, expr ast.Expr) bool {
+	rangeIdent, isRangeIdent := rangeVar.(*ast.Ident)
+	ident, isIdent := expr.(*ast.Ident)
+
+	return isRangeIdent && isIdent && info.ObjectOf(rangeIdent) != nil &&
+		info.ObjectOf(rangeIdent) == tableDrivenInfo.RangeVarObject(info, ident)
+}
+
+// fieldStringConstant returns the value of the field of the struct literal, empty if the field is omitted.
+// It returns false if the value is not a string constant, or if the field is not a direct field of the struct, like
+// a field promoted from an embedded struct, since its value can't be found in the literal.
+func fieldStringConstant(info *types.Info, elt ast.Expr, fieldName string) (string, bool) {
+	if unaryExpr, isUnaryExpr := elt.(*ast.UnaryExpr); isUnaryExpr {
+		elt = unaryExpr.X
+	}
+
+	compositeLit, isCompositeLit := elt.(*ast.CompositeLit)
+	if !isCompositeLit {
+		return "", false
+	}
+
+	structType, isStruct := deref(info.TypeOf(compositeLit)).Underlying().(*types.Struct)
+	if !isStruct {
+		return "", false
+	}
+
+	for i, e := range compositeLit.Elts {
+		if kv, isKeyValue := e.(*ast.KeyValueExpr); isKeyValue {
+			if ident, isIdent := kv.Key.(*ast.Ident); isIdent && ident.Name == fieldName {
+				return stringConstant(info, kv.Value)
+			}
+
+			continue
+		}
+
+		// positional fields
+		if i < structType.NumFields() && structType.Field(i).Name() == fieldName {
+			return stringConstant(info, e)
+		}
+	}
+
+	for i := range structType.NumFields() {
+		if structType.Field(i).Name() == fieldName {
+			return "", true
+		}
+	}
+
+	return "", false
+}
+
+// stringConstant returns the value of the expression if it's a string constant.
+func stringConstant(info *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// deref returns the type the pointer points to, or the type itself if it's not a pointer.
+func deref(t types.Type) types.Type {
+	if t == nil {
+		return types.Typ[types.Invalid]
+	}
+
+	if ptr, isPtr := t.Underlying().(*types.Pointer); isPtr {
+		return ptr.Elem()
+	}
+
+	return t
+}
@@ -15,7 +15,7 @@ const (
 
 type (
 	TableDrivenFormatType      string
-	TableDrivenFormatPredicate func(testFunc model.TestFunction) *analysis.Diagnostic
+	TableDrivenFormatPredicate func(pass *analysis.Pass, testFunc model.TestFunction) *analysis.Diagnostic
 
 	TableDrivenFormat struct {
 		pred TableDrivenFormatPredicate
@@ -33,7 +33,7 @@ func (e TableDrivenFormatTypeError) Error() string {
 }
 
 func AlwaysValid() TableDrivenFormatPredicate {
-	return func(testFunc model.TestFunction) *analysis.Diagnostic {
+	return func(_ *analysis.Pass, _ model.TestFunction) *analysis.Diagnostic {
 		return nil
 	}
 }
@@ -49,18 +49,39 @@ func OfTypeAndInline(formatType TableDrivenFormatType, inline bool) (TableDriven
 	}
 
 	expectedMessage := fmt.Sprintf("Expected %s-%s table driven test", formatType, inlinedNonInlinedMessage)
+	// the shared tables can be used by other tests, so they are not inlined nor rewritten by a suggested fix.
+	expectedSharedMessage := fmt.Sprintf("Expected %s table driven test", formatType)
 
-	return func(testFunc model.TestFunction) *analysis.Diagnostic {
+	return func(pass *analysis.Pass, testFunc model.TestFunction) *analysis.Diagnostic {
 		info := testFunc.GetTableDrivenInfo()
-		if testFunc.GetTableDrivenInfo().FormatType != string(formatType) || info.Inlined != inline {
+		if info.FormatType == string(formatType) && (info.Inlined == inline || info.IsShared()) {
+			return nil
+		}
+
+		if info.IsShared() {
 			return &analysis.Diagnostic{
 				Pos:     info.Range.Pos(),
 				End:     info.Range.End(),
-				Message: expectedMessage,
+				Message: expectedSharedMessage,
 			}
 		}
 
-		return nil
+		diag := &analysis.Diagnostic{
+			Pos:     info.Range.Pos(),
+			End:     info.Range.End(),
+			Message: expectedMessage,
+		}
+
+		if edits, ok := tableDrivenFormatTextEdits(pass, testFunc, formatType, inline); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{
+				{
+					Message:   expectedMessage,
+					TextEdits: edits,
+				},
+			}
+		}
+
+		return diag
 	}, nil
 }
 
@@ -77,12 +98,16 @@ func NewTableDrivenFormat(pred TableDrivenFormatPredicate) (TableDrivenFormat, e
 }
 
 func (c TableDrivenFormat) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind) {
+		return
+	}
+
 	info := testFunc.GetTableDrivenInfo()
 	if info == nil {
 		return
 	}
 
-	diag := c.pred(testFunc)
+	diag := c.pred(pass, testFunc)
 	if diag != nil {
 		diag.Category = c.category
 		pass.Report(*diag)
@@ -0,0 +1,502 @@
+package checks
+
+import (
+	"cmp"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// tableDrivenFormatTextEdits returns the edits that convert the table-driven test into the expected format type
+// and inlined mode, or false if the conversion can't be done mechanically.
+func tableDrivenFormatTextEdits(
+	pass *analysis.Pass,
+	testFunc model.TestFunction,
+	formatType TableDrivenFormatType,
+	inline bool,
+) ([]analysis.TextEdit, bool) {
+	info := testFunc.GetTableDrivenInfo()
+
+	edits, ok := mapSliceTextEdits(pass, testFunc, formatType)
+	if !ok {
+		return nil, false
+	}
+
+	if info.Inlined == inline {
+		return edits, true
+	}
+
+	// the table is moved, so the edits inside the table are applied to the moved text.
+	tableEdits := make([]analysis.TextEdit, 0, len(edits))
+	otherEdits := make([]analysis.TextEdit, 0, len(edits))
+
+	for _, edit := range edits {
+		if edit.Pos >= info.Table.Pos() && edit.End <= info.Table.End() {
+			tableEdits = append(tableEdits, edit)
+		} else {
+			otherEdits = append(otherEdits, edit)
+		}
+	}
+
+	tableText, ok := applyTextEdits(pass, info.Table, tableEdits)
+	if !ok {
+		return nil, false
+	}
+
+	var inlinedEdits []analysis.TextEdit
+	if inline {
+		inlinedEdits, ok = inlineTableTextEdits(pass, testFunc, tableText)
+	} else {
+		inlinedEdits, ok = hoistTableTextEdits(pass, info, tableText)
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	return append(otherEdits, inlinedEdits...), true
+}
+
+// hoistTableTextEdits moves the table declared in the range statement into a `tests` variable declared
+// right before the loop.
+func hoistTableTextEdits(pass *analysis.Pass, info *model.TableDrivenInfo, tableText string) ([]analysis.TextEdit, bool) {
+	const tableName = "tests"
+
+	if isNameInScope(pass, info.Range.Pos(), tableName) {
+		return nil, false
+	}
+
+	return []analysis.TextEdit{
+		{
+			Pos:     info.Range.Pos(),
+			End:     info.Range.Pos(),
+			NewText: []byte(tableName + " := " + tableText + "\n"),
+		},
+		{
+			Pos:     info.Table.Pos(),
+			End:     info.Table.End(),
+			NewText: []byte(tableName),
+		},
+	}, true
+}
+
+// inlineTableTextEdits moves the table assigned to a variable into the range statement, removing the assignment.
+// The variable must be used only in the range statement.
+func inlineTableTextEdits(pass *analysis.Pass, testFunc model.TestFunction, tableText string) ([]analysis.TextEdit, bool) {
+	info := testFunc.GetTableDrivenInfo()
+	if !isTableVarUsedOnlyInRange(pass, testFunc) {
+		return nil, false
+	}
+
+	// remove the assignment up to the end of its line
+	tokFile := pass.Fset.File(info.Assign.End())
+	end := info.Assign.End()
+
+	if line := tokFile.Line(end); line < tokFile.LineCount() {
+		end = tokFile.LineStart(line + 1)
+	}
+
+	return []analysis.TextEdit{
+		{
+			Pos:     info.Assign.Pos(),
+			End:     end,
+			NewText: []byte(""),
+		},
+		{
+			Pos:     info.Range.X.Pos(),
+			End:     info.Range.X.End(),
+			NewText: []byte(tableText),
+		},
+	}, true
+}
+
+// isTableVarUsedOnlyInRange returns whether the variable the table is assigned to is used only in the range statement,
+// so the table can be changed without breaking other uses of the variable, like `tests[0]`.
+func isTableVarUsedOnlyInRange(pass *analysis.Pass, testFunc model.TestFunction) bool {
+	info := testFunc.GetTableDrivenInfo()
+	if info.Assign == nil || len(info.Assign.Lhs) != 1 {
+		return false
+	}
+
+	tableVar, ok := info.Assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	tableObj := pass.TypesInfo.ObjectOf(tableVar)
+
+	var uses []*ast.Ident
+
+	ast.Inspect(testFunc.GetBody(), func(n ast.Node) bool {
+		if ident, isIdent := n.(*ast.Ident); isIdent && pass.TypesInfo.Uses[ident] == tableObj {
+			uses = append(uses, ident)
+		}
+
+		return true
+	})
+
+	return len(uses) == 1 && uses[0] == ast.Unparen(info.Range.X)
+}
+
+// applyTextEdits returns the source code of the node after applying the edits, that must be inside the node.
+func applyTextEdits(pass *analysis.Pass, node ast.Node, edits []analysis.TextEdit) (string, bool) {
+	if pass.ReadFile == nil {
+		return "", false
+	}
+
+	tokFile := pass.Fset.File(node.Pos())
+
+	content, err := pass.ReadFile(tokFile.Name())
+	if err != nil {
+		return "", false
+	}
+
+	start := tokFile.Offset(node.Pos())
+	text := string(content[start:tokFile.Offset(node.End())])
+
+	sorted := slices.Clone(edits)
+	slices.SortFunc(sorted, func(a, b analysis.TextEdit) int {
+		return cmp.Compare(b.Pos, a.Pos)
+	})
+
+	for _, edit := range sorted {
+		from, to := tokFile.Offset(edit.Pos)-start, tokFile.Offset(edit.End)-start
+		text = text[:from] + string(edit.NewText) + text[to:]
+	}
+
+	return text, true
+}
+
+// mapSliceTextEdits returns the edits that convert the table of the table-driven test into formatType,
+// or false if the conversion can't be done mechanically, like when the table variable is also used outside the
+// range statement.
+func mapSliceTextEdits(
+	pass *analysis.Pass,
+	testFunc model.TestFunction,
+	formatType TableDrivenFormatType,
+) ([]analysis.TextEdit, bool) {
+	info := testFunc.GetTableDrivenInfo()
+
+	switch {
+	case info.FormatType == string(formatType):
+		return nil, true
+	case info.Source == model.LocalTable && !isTableVarUsedOnlyInRange(pass, testFunc):
+		return nil, false
+	case formatType == Map:
+		return sliceToMapTextEdits(pass, info)
+	default:
+		return mapToSliceTextEdits(pass, info)
+	}
+}
+
+// sliceToMapTextEdits converts a `[]struct{name string; ...}` table into `map[string]struct{...}`.
+// The values of the field used as subtest name, that must be constant and distinct, become the keys of the map, and
+// the field accesses inside the loop (e.g. `t.Run(tc.name, ...)`) are replaced by the key of the range statement.
+//
+//nolint:gocognit,funlen // refactor later
+func sliceToMapTextEdits(pass *analysis.Pass, info *model.TableDrivenInfo) ([]analysis.TextEdit, bool) {
+	arrayType, ok := info.Table.Type.(*ast.ArrayType)
+	if !ok || arrayType.Len != nil {
+		return nil, false
+	}
+
+	structType, ok := arrayType.Elt.(*ast.StructType)
+	if !ok {
+		return nil, false
+	}
+
+	if info.Range.Tok != token.DEFINE {
+		return nil, false
+	}
+
+	if key, isIdent := info.Range.Key.(*ast.Ident); !isIdent || key.Name != "_" {
+		return nil, false
+	}
+
+	valueVar, ok := info.Range.Value.(*ast.Ident)
+	if !ok || valueVar.Name == "_" {
+		return nil, false
+	}
+
+	valueObj := pass.TypesInfo.ObjectOf(valueVar)
+
+	// the subtest name is used as key, so there is no key to use without subtests.
+	if !info.Subtests {
+		return nil, false
+	}
+
+	nameSelector, ok := info.Run.Args[0].(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+
+	if ident, isIdent := nameSelector.X.(*ast.Ident); !isIdent || info.RangeVarObject(pass.TypesInfo, ident) != valueObj {
+		return nil, false
+	}
+
+	fieldName := nameSelector.Sel.Name
+	if isNameInScope(pass, info.Range.Body.Pos(), fieldName) {
+		return nil, false
+	}
+
+	fields := make([]ast.Node, len(structType.Fields.List))
+	fieldIndex := -1
+
+	for i, field := range structType.Fields.List {
+		fields[i] = field
+
+		if len(field.Names) == 1 && field.Names[0].Name == fieldName && isString(pass.TypesInfo.TypeOf(field.Type)) {
+			fieldIndex = i
+		}
+	}
+
+	if fieldIndex == -1 {
+		return nil, false
+	}
+
+	edits := []analysis.TextEdit{
+		{
+			Pos:     arrayType.Pos(),
+			End:     arrayType.Elt.Pos(),
+			NewText: []byte("map[string]"),
+		},
+		removeListItemEdit(fields, fieldIndex, structType.Fields.Opening, structType.Fields.Closing),
+	}
+
+	// the names become the keys of the map, so they must be constant and distinct.
+	names := make(map[string]bool, len(info.Table.Elts))
+
+	for _, elt := range info.Table.Elts {
+		compositeLit, isCompositeLit := elt.(*ast.CompositeLit)
+		if !isCompositeLit {
+			return nil, false
+		}
+
+		keyValues := make([]ast.Node, len(compositeLit.Elts))
+		nameIndex := -1
+
+		var nameValue ast.Expr
+
+		for i, e := range compositeLit.Elts {
+			kv, isKeyValue := e.(*ast.KeyValueExpr)
+			if !isKeyValue {
+				return nil, false
+			}
+
+			keyValues[i] = kv
+
+			if ident, isIdent := kv.Key.(*ast.Ident); isIdent && ident.Name == fieldName {
+				nameIndex, nameValue = i, kv.Value
+			}
+		}
+
+		if nameIndex == -1 {
+			return nil, false
+		}
+
+		name, isConstant := stringConstant(pass.TypesInfo, nameValue)
+		if !isConstant || names[name] {
+			return nil, false
+		}
+
+		names[name] = true
+
+		edits = append(edits,
+			analysis.TextEdit{
+				Pos:     compositeLit.Pos(),
+				End:     compositeLit.Pos(),
+				NewText: []byte(nodeText(pass.Fset, nameValue) + ": "),
+			},
+			removeListItemEdit(keyValues, nameIndex, compositeLit.Lbrace, compositeLit.Rbrace),
+		)
+	}
+
+	edits = append(edits, analysis.TextEdit{
+		Pos:     info.Range.Key.Pos(),
+		End:     info.Range.Key.End(),
+		NewText: []byte(fieldName),
+	})
+
+	ast.Inspect(info.Range.Body, func(n ast.Node) bool {
+		selectorExpr, isSelectorExpr := n.(*ast.SelectorExpr)
+		if !isSelectorExpr || selectorExpr.Sel.Name != fieldName {
+			return true
+		}
+
+		if ident, isIdent := selectorExpr.X.(*ast.Ident); isIdent && info.RangeVarObject(pass.TypesInfo, ident) == valueObj {
+			edits = append(edits, analysis.TextEdit{
+				Pos:     selectorExpr.Pos(),
+				End:     selectorExpr.End(),
+				NewText: []byte(fieldName),
+			})
+
+			return false
+		}
+
+		return true
+	})
+
+	return edits, true
+}
+
+// mapToSliceTextEdits converts a `map[string]struct{...}` table into `[]struct{name string; ...}`.
+// The keys of the map become the values of a new field named like the key of the range statement, and the uses
+// of the key inside the loop (e.g. `t.Run(name, ...)`) are replaced by the new field.
+//
+//nolint:gocognit,funlen // refactor later
+func mapToSliceTextEdits(pass *analysis.Pass, info *model.TableDrivenInfo) ([]analysis.TextEdit, bool) {
+	mapType, ok := info.Table.Type.(*ast.MapType)
+	if !ok || !isString(pass.TypesInfo.TypeOf(mapType.Key)) {
+		return nil, false
+	}
+
+	structType, ok := mapType.Value.(*ast.StructType)
+	if !ok {
+		return nil, false
+	}
+
+	if info.Range.Tok != token.DEFINE {
+		return nil, false
+	}
+
+	keyVar, ok := info.Range.Key.(*ast.Ident)
+	if !ok || keyVar.Name == "_" {
+		return nil, false
+	}
+
+	valueVar, ok := info.Range.Value.(*ast.Ident)
+	if !ok || valueVar.Name == "_" {
+		return nil, false
+	}
+
+	fieldName := keyVar.Name
+
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return nil, false
+			}
+		}
+	}
+
+	edits := []analysis.TextEdit{
+		{
+			Pos:     mapType.Pos(),
+			End:     mapType.Value.Pos(),
+			NewText: []byte("[]"),
+		},
+		insertListItemEdit(pass.Fset, len(structType.Fields.List), structType.Fields.Opening,
+			structType.Fields.Closing, fieldName+" string", "; ", ""),
+	}
+
+	for _, elt := range info.Table.Elts {
+		kv, isKeyValue := elt.(*ast.KeyValueExpr)
+		if !isKeyValue {
+			return nil, false
+		}
+
+		value, isCompositeLit := kv.Value.(*ast.CompositeLit)
+		if !isCompositeLit {
+			return nil, false
+		}
+
+		for _, e := range value.Elts {
+			if _, isKeyValueElt := e.(*ast.KeyValueExpr); !isKeyValueElt {
+				return nil, false
+			}
+		}
+
+		edits = append(edits,
+			analysis.TextEdit{
+				Pos:     kv.Pos(),
+				End:     kv.Value.Pos(),
+				NewText: []byte(""),
+			},
+			insertListItemEdit(pass.Fset, len(value.Elts), value.Lbrace, value.Rbrace,
+				fieldName+": "+nodeText(pass.Fset, kv.Key), ", ", ","),
+		)
+	}
+
+	edits = append(edits, analysis.TextEdit{
+		Pos:     keyVar.Pos(),
+		End:     keyVar.End(),
+		NewText: []byte("_"),
+	})
+
+	keyObj := pass.TypesInfo.ObjectOf(keyVar)
+
+	ast.Inspect(info.Range.Body, func(n ast.Node) bool {
+		if ident, isIdent := n.(*ast.Ident); isIdent && pass.TypesInfo.Uses[ident] == keyObj {
+			edits = append(edits, analysis.TextEdit{
+				Pos:     ident.Pos(),
+				End:     ident.End(),
+				NewText: []byte(valueVar.Name + "." + fieldName),
+			})
+		}
+
+		return true
+	})
+
+	return edits, true
+}
+
+// removeListItemEdit returns the edit that removes the i-th item of a list delimited by opening and closing,
+// including its separator.
+func removeListItemEdit(items []ast.Node, i int, opening, closing token.Pos) analysis.TextEdit {
+	switch {
+	case len(items) == 1:
+		return analysis.TextEdit{Pos: opening + 1, End: closing, NewText: []byte("")}
+	case i < len(items)-1:
+		return analysis.TextEdit{Pos: items[i].Pos(), End: items[i+1].Pos(), NewText: []byte("")}
+	default:
+		return analysis.TextEdit{Pos: items[i-1].End(), End: items[i].End(), NewText: []byte("")}
+	}
+}
+
+// insertListItemEdit returns the edit that inserts item as the first item of a list delimited by opening and closing.
+// If the list spans multiple lines, the item is inserted in its own line followed by the terminator,
+// otherwise it's followed by the separator.
+func insertListItemEdit(
+	fset *token.FileSet,
+	length int,
+	opening, closing token.Pos,
+	item, separator, terminator string,
+) analysis.TextEdit {
+	tokFile := fset.File(opening)
+	if line := tokFile.Line(opening); line != tokFile.Line(closing) {
+		// insert it at the beginning of the next line, so comments after the opening stay where they are.
+		pos := tokFile.LineStart(line + 1)
+
+		return analysis.TextEdit{Pos: pos, End: pos, NewText: []byte(item + terminator + "\n")}
+	}
+
+	newText := item
+	if length > 0 {
+		newText += separator
+	}
+
+	return analysis.TextEdit{Pos: opening + 1, End: opening + 1, NewText: []byte(newText)}
+}
+
+// isNameInScope returns whether the name is resolved to an object at the given position.
+func isNameInScope(pass *analysis.Pass, pos token.Pos, name string) bool {
+	scope := pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return false
+	}
+
+	_, obj := scope.LookupParent(name, pos)
+
+	return obj != nil
+}
+
+func isString(t types.Type) bool {
+	basic, ok := types.Unalias(t).(*types.Basic)
+
+	return ok && basic.Kind() == types.String
+}
@@ -0,0 +1,49 @@
+package checks
+
+import (
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/manuelarte/testcommentslint/analyzer/model"
+)
+
+// UseSubtests check that the test cases of the table-driven tests are run in subtests with t.Run.
+type UseSubtests struct {
+	category string
+}
+
+// NewUseSubtests creates a new UseSubtests.
+func NewUseSubtests() UseSubtests {
+	return UseSubtests{
+		category: "Use Subtests",
+	}
+}
+
+// Check checks that the range statement of a table-driven test runs each test case in a t.Run subtest, instead of
+// comparing the values directly in the loop body, like in:
+//
+//	for _, tc := range tests {
+//		got := YourFunc(tc.in)
+//		if got != tc.want {
+//			t.Errorf(...)
+//		}
+//	}
+func (c UseSubtests) Check(pass *analysis.Pass, testFunc model.TestFunction) {
+	if !testFunc.IsKind(model.TestKind, model.BenchmarkKind) {
+		return
+	}
+
+	info := testFunc.GetTableDrivenInfo()
+	if info == nil || info.Subtests {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      info.Range.Pos(),
+		End:      info.Range.Body.Lbrace,
+		Category: c.category,
+		Message: "Run each test case in a subtest with " + testFunc.GetTestVar() + ".Run, " +
+			"so the test cases can be run on their own and keep going after a fatal failure",
+		URL: "https://github.com/manuelarte/testcommentslint/tree/main?tab=readme-ov-file#use-subtests",
+	}
+	pass.Report(diag)
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// IsAssertionFunc returns whether the function belongs to an assertion library.
+type IsAssertionFunc func(fn *types.Func) bool
+
+// AssertionCallExpr is a call into an assertion library, like:
+//
+//	got := MyFunction(in)
+//	assert.Equal(t, want, got)
+type AssertionCallExpr struct {
+	// callExpr is the outermost call into the assertion library, like Expect(got).To(Equal(want)).
+	callExpr *ast.CallExpr
+
+	// fn is the function or method called.
+	fn *types.Func
+
+	// stmt is the statement that contains only the call, nil if the call is part of another statement.
+	stmt *ast.ExprStmt
+
+	// testedFunc contain the call to the function tested, if the call is a statement and the tested function
+	// is found in the previous statements.
+	testedFunc *TestedCallExpr
+}
+
+// CallExpr returns the call into the assertion library.
+func (a AssertionCallExpr) CallExpr() *ast.CallExpr {
+	return a.callExpr
+}
+
+// Func returns the function or method of the assertion library called.
+func (a AssertionCallExpr) Func() *types.Func {
+	return a.fn
+}
+
+// Stmt returns the statement that contains only the call, nil if the call is part of another statement,
+// like in `if !assert.Equal(t, want, got) {`.
+func (a AssertionCallExpr) Stmt() *ast.ExprStmt {
+	return a.stmt
+}
+
+// TestedFunc returns the call to the function tested, if found.
+func (a AssertionCallExpr) TestedFunc() (TestedCallExpr, bool) {
+	if a.testedFunc == nil {
+		return TestedCallExpr{}, false
+	}
+
+	return *a.testedFunc, true
+}
+
+// AssertionCallExprs returns the calls into assertion libraries found anywhere in the test function.
+// Only the outermost call is returned for chained or nested calls, like in Expect(got).To(Equal(want)).
+func (t TestFunction) AssertionCallExprs(isAssertionFunc IsAssertionFunc) []AssertionCallExpr {
+	toReturn := make([]AssertionCallExpr, 0)
+
+	if t.body == nil {
+		return toReturn
+	}
+
+	found := make(map[*ast.CallExpr]bool)
+
+	ast.Inspect(t.body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BlockStmt:
+			// the calls that are statements are looked for first, so the tested function can be found in the previous
+			// statements of the block.
+			for i, stmt := range node.List {
+				exprStmt, isExprStmt := stmt.(*ast.ExprStmt)
+				if !isExprStmt {
+					continue
+				}
+
+				assertionCallExpr, ok := newAssertionCallExpr(t.info, isAssertionFunc, exprStmt.X)
+				if !ok {
+					continue
+				}
+
+				assertionCallExpr.stmt = exprStmt
+
+				// the arguments order depends on the library, so the expected values are told apart by their names.
+				gotIdents, otherIdents := splitByName(varIdents(t.info, exprStmt.X))
+				testedStmt := testedCallStmt(t.info, node.List[:i], gotIdents, otherIdents)
+				if testedFunc, isTestedFunc := NewTestedCallExpr(testedStmt); isTestedFunc {
+					assertionCallExpr.testedFunc = &testedFunc
+				}
+
+				found[assertionCallExpr.callExpr] = true
+				toReturn = append(toReturn, assertionCallExpr)
+			}
+		case *ast.CallExpr:
+			if found[node] {
+				return false
+			}
+
+			if assertionCallExpr, ok := newAssertionCallExpr(t.info, isAssertionFunc, node); ok {
+				toReturn = append(toReturn, assertionCallExpr)
+
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return toReturn
+}
+
+func newAssertionCallExpr(info *types.Info, isAssertionFunc IsAssertionFunc, expr ast.Expr) (AssertionCallExpr, bool) {
+	callExpr, isCallExpr := expr.(*ast.CallExpr)
+	if !isCallExpr {
+		return AssertionCallExpr{}, false
+	}
+
+	fn, isFunc := typeutil.Callee(info, callExpr).(*types.Func)
+	if !isFunc || fn.Pkg() == nil || !isAssertionFunc(fn) {
+		return AssertionCallExpr{}, false
+	}
+
+	return AssertionCallExpr{
+		callExpr: callExpr,
+		fn:       fn,
+	}, true
+}
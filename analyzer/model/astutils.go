@@ -2,67 +2,228 @@ package model
 
 import (
 	"go/ast"
+	"go/types"
 	"strings"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// GoCmpPkgPath is the path of the go-cmp package, that compares the values in the tests.
+const GoCmpPkgPath = "github.com/google/go-cmp/cmp"
+
+const (
+	reflectPkgPath = "reflect"
+	stringsPkgPath = "strings"
+	testingPkgPath = "testing"
 )
 
-// IsReflectImport returns whether the ast.ImportSpec is from the reflect package.
-func IsReflectImport(i *ast.ImportSpec) bool {
-	if i.Path == nil || i.Path.Value != "\"reflect\"" {
+// testEntryPoint is a kind of function run by go test.
+type testEntryPoint struct {
+	// handle is the name of the testing type received, T, B or F.
+	handle string
+	// kind is the kind of the test function.
+	kind TestFunctionKind
+}
+
+// testEntryPoints contains the prefixes of the functions run by go test, and the testing handle they receive.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var testEntryPoints = map[string]testEntryPoint{
+	"Test":      {handle: "T", kind: TestKind},
+	"Benchmark": {handle: "B", kind: BenchmarkKind},
+	"Fuzz":      {handle: "F", kind: FuzzKind},
+}
+
+//nolint:gochecknoglobals // read-only lookup table
+var (
+	// stringsMatchFuncs contains the functions of the strings package that match a string against another one.
+	stringsMatchFuncs = []string{"Contains", "HasPrefix", "HasSuffix", "EqualFold", "Index"}
+
+	// errorInterface is the predeclared error interface.
+	errorInterface, _ = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+)
+
+// IsReflectDeepEqual returns whether the call expression is a call to reflect.DeepEqual.
+func IsReflectDeepEqual(info *types.Info, callExpr *ast.CallExpr) bool {
+	return isPkgFuncCall(info, callExpr, reflectPkgPath, "DeepEqual")
+}
+
+// IsGoCmpEqual returns whether the call expression is a call to go-cmp cmp.Equal.
+func IsGoCmpEqual(info *types.Info, callExpr *ast.CallExpr) bool {
+	return isPkgFuncCall(info, callExpr, GoCmpPkgPath, "Equal")
+}
+
+// IsGoCmpDiff returns whether the call expression is a call to go-cmp cmp.Diff.
+func IsGoCmpDiff(info *types.Info, callExpr *ast.CallExpr) bool {
+	return isPkgFuncCall(info, callExpr, GoCmpPkgPath, "Diff")
+}
+
+// IsStringsMatch returns whether the call expression is a call to a function of the strings package that matches
+// a string against another one, like strings.Contains or strings.HasPrefix.
+func IsStringsMatch(info *types.Info, callExpr *ast.CallExpr) bool {
+	for _, name := range stringsMatchFuncs {
+		if isPkgFuncCall(info, callExpr, stringsPkgPath, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsTestingHandle returns whether the type of the expression is *testing.T, *testing.B, *testing.F or testing.TB.
+func IsTestingHandle(info *types.Info, expr ast.Expr) bool {
+	return isTestingHandle(info.TypeOf(expr))
+}
+
+// IsError returns whether the type of the expression implements the error interface.
+func IsError(info *types.Info, expr ast.Expr) bool {
+	t := info.TypeOf(expr)
+	if t == nil || isNil(info, expr) {
 		return false
 	}
 
-	return true
+	return types.Implements(t, errorInterface)
 }
 
-// IsGoCmpImport returns whether the ast.ImportSpec is from go-cmp package.
-func IsGoCmpImport(i *ast.ImportSpec) bool {
-	if i.Path == nil || i.Path.Value != "\"github.com/google/go-cmp/cmp\"" {
+// IsErrorMessage returns whether the expression gets the message of an error, like in err.Error().
+func IsErrorMessage(info *types.Info, expr ast.Expr) bool {
+	callExpr, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(callExpr.Args) != 0 {
 		return false
 	}
 
-	return true
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok || selectorExpr.Sel.Name != "Error" {
+		return false
+	}
+
+	return IsError(info, selectorExpr.X)
 }
 
-// importName returns the import name for a package, either the actual name of the alias.
-func importName(is *ast.ImportSpec) string {
-	if is.Name != nil {
-		return is.Name.Name
+// isPkgFuncCall returns whether the call expression is a call to the package level function pkgPath.name.
+// The function is resolved through the type information, so aliased imports, dot imports and shadowed identifiers
+// are taken into account.
+func isPkgFuncCall(info *types.Info, callExpr *ast.CallExpr, pkgPath, name string) bool {
+	if info == nil || callExpr == nil {
+		return false
 	}
 
-	unquoted := is.Path.Value[1 : len(is.Path.Value)-1]
-	parts := strings.Split(unquoted, "/")
+	fn := typeutil.StaticCallee(info, callExpr)
+	if fn == nil || fn.Pkg() == nil {
+		return false
+	}
 
-	return parts[len(parts)-1]
+	if sig, ok := fn.Type().(*types.Signature); !ok || sig.Recv() != nil {
+		return false
+	}
+
+	return fn.Pkg().Path() == pkgPath && fn.Name() == name
 }
 
-//nolint:nestif // no need
-func isTestFunction(funcDecl *ast.FuncDecl) (bool, string) {
-	testMethodPackageType := "testing"
-	testMethodStruct := "T"
-	testPrefix := "Test"
+// isNamedType returns whether the type is the named type pkgPath.name.
+func isNamedType(t types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
 
-	if !strings.HasPrefix(funcDecl.Name.Name, testPrefix) {
-		return false, ""
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// testFunctionKind returns the kind of the function and its testing handle parameter, if the function is a test
+// entry point or a helper.
+func testFunctionKind(info *types.Info, funcDecl *ast.FuncDecl) (TestFunctionKind, *ast.Ident, bool) {
+	testVar, ok := receivesTestingHandle(info, funcDecl.Type)
+	if !ok {
+		return "", nil, false
+	}
+
+	if !isTestEntryPoint(info, funcDecl) {
+		return HelperKind, testVar, true
+	}
+
+	for prefix, entryPoint := range testEntryPoints {
+		if strings.HasPrefix(funcDecl.Name.Name, prefix) {
+			return entryPoint.kind, testVar, true
+		}
 	}
 
-	if funcDecl.Type.Params != nil && len(funcDecl.Type.Params.List) != 1 {
-		return false, ""
+	return "", nil, false
+}
+
+// isTestEntryPoint returns whether the function is run by go test, this is, a TestXxx, BenchmarkXxx or FuzzXxx
+// function that receives a single *testing.T, *testing.B or *testing.F.
+func isTestEntryPoint(info *types.Info, funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Recv != nil || funcDecl.Type.Params == nil || len(funcDecl.Type.Params.List) != 1 {
+		return false
 	}
 
 	param := funcDecl.Type.Params.List[0]
-	if starExp, ok := param.Type.(*ast.StarExpr); ok {
-		if selectExpr, isSelector := starExp.X.(*ast.SelectorExpr); isSelector {
-			if selectExpr.Sel.Name == testMethodStruct {
-				if s, isIdent := selectExpr.X.(*ast.Ident); isIdent {
-					if len(param.Names) > 0 {
-						return s.Name == testMethodPackageType, param.Names[0].Name
-					}
-				}
+	if len(param.Names) > 1 {
+		return false
+	}
+
+	for prefix, entryPoint := range testEntryPoints {
+		if !strings.HasPrefix(funcDecl.Name.Name, prefix) {
+			continue
+		}
+
+		ptr, ok := types.Unalias(info.TypeOf(param.Type)).(*types.Pointer)
+
+		return ok && isNamedType(ptr.Elem(), testingPkgPath, entryPoint.handle)
+	}
+
+	return false
+}
+
+// receivesTestingHandle returns the first named parameter of the function that is a testing handle,
+// *testing.T, *testing.B, *testing.F or testing.TB.
+func receivesTestingHandle(info *types.Info, funcType *ast.FuncType) (*ast.Ident, bool) {
+	if funcType.Params == nil {
+		return nil, false
+	}
+
+	for _, param := range funcType.Params.List {
+		if !isTestingHandle(info.TypeOf(param.Type)) {
+			continue
+		}
+
+		for _, name := range param.Names {
+			if name.Name != "_" {
+				return name, true
 			}
 		}
 	}
 
-	return false, ""
+	return nil, false
+}
+
+// isTestingHandle returns whether the type is *testing.T, *testing.B, *testing.F or testing.TB.
+func isTestingHandle(t types.Type) bool {
+	if isNamedType(t, testingPkgPath, "TB") {
+		return true
+	}
+
+	ptr, ok := types.Unalias(t).(*types.Pointer)
+	if !ok {
+		return false
+	}
+
+	return isNamedType(ptr.Elem(), testingPkgPath, "T") ||
+		isNamedType(ptr.Elem(), testingPkgPath, "B") ||
+		isNamedType(ptr.Elem(), testingPkgPath, "F")
+}
+
+// isTestingHandleMethodCall returns whether the call expression is a call to the method name of a testing handle.
+func isTestingHandleMethodCall(info *types.Info, callExpr *ast.CallExpr, name string) bool {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok || selectorExpr.Sel.Name != name {
+		return false
+	}
+
+	return isTestingHandle(info.TypeOf(selectorExpr.X))
 }
 
 func isMapOrSliceCompositeLit(expr ast.Expr) *ast.CompositeLit {
@@ -91,44 +252,19 @@ func isNotBlankIdent(expr ast.Expr) (*ast.Ident, bool) {
 	return ident, true
 }
 
-func isGoCmpDiff(goCmpImportAlias string, se *ast.SelectorExpr) bool {
-	if se == nil {
-		return false
-	}
+// isNil returns whether the expression is the predeclared nil.
+func isNil(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
 
-	if ident, ok := se.X.(*ast.Ident); ok {
-		if ident.Name == goCmpImportAlias && se.Sel.Name == "Diff" {
-			return true
-		}
-	}
-
-	return false
+	return ok && tv.IsNil()
 }
 
-func isGoCmpEqual(goCmpImportAlias string, se *ast.SelectorExpr) bool {
-	if se == nil {
+// isSameObject returns whether both identifiers refer to the same object.
+func isSameObject(info *types.Info, a, b *ast.Ident) bool {
+	objA := info.ObjectOf(a)
+	if objA == nil {
 		return false
 	}
 
-	if ident, ok := se.X.(*ast.Ident); ok {
-		if ident.Name == goCmpImportAlias && se.Sel.Name == "Equal" {
-			return true
-		}
-	}
-
-	return false
-}
-
-func isReflectEqual(reflectImportAlias string, se *ast.SelectorExpr) bool {
-	if se == nil {
-		return false
-	}
-
-	if ident, ok := se.X.(*ast.Ident); ok {
-		if ident.Name == reflectImportAlias && se.Sel.Name == "DeepEqual" {
-			return true
-		}
-	}
-
-	return false
+	return objA == info.ObjectOf(b)
 }
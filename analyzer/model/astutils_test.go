@@ -2,8 +2,10 @@ package model
 
 import (
 	"go/ast"
+	"go/importer"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -20,6 +22,8 @@ func TestNewTableDrivenTestInfoIsTableDrivenTest(t *testing.T) {
 			content: `
 package main
 
+import "testing"
+
 func TestExample(t *testing.T) {
   tests := map[string]struct {
     input  string
@@ -49,6 +53,8 @@ func TestExample(t *testing.T) {
 			content: `
 package main
 
+import "testing"
+
 func TestExample(t *testing.T) {
 	for name, test := range map[string]struct {
 		in int
@@ -77,6 +83,8 @@ func TestExample(t *testing.T) {
 			content: `
 package main
 
+import "testing"
+
 func TestExample(t *testing.T) {
   tests := []struct {
     desc   string
@@ -108,6 +116,8 @@ func TestExample(t *testing.T) {
 			content: `
 package main
 
+import "testing"
+
 func TestExample(t *testing.T) {
   for _, test := range []struct {
 		name string
@@ -134,21 +144,81 @@ func TestExample(t *testing.T) {
 				return funcDecl.Body.List[0].(*ast.RangeStmt).Body.List[0].(*ast.ExprStmt).X.(*ast.CallExpr).Args[1].(*ast.FuncLit).Body
 			},
 		},
+		"slice non-inline table driven test with rebinding and logging": {
+			content: `
+package main
+
+import "testing"
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "example",
+			input: "1",
+			want:  1,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Logf("running %s", tc.name)
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := parse(tc.input)
+			if got != tc.want {
+				t.Errorf("parse got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+			`[1:],
+			wantBlock: func(funcDecl *ast.FuncDecl) *ast.BlockStmt {
+				//nolint:lll
+				return funcDecl.Body.List[1].(*ast.RangeStmt).Body.List[2].(*ast.ExprStmt).X.(*ast.CallExpr).Args[1].(*ast.FuncLit).Body
+			},
+		},
+		"slice non-inline table driven test without subtests": {
+			content: `
+package main
+
+import "testing"
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{
+			input: "1",
+			want:  1,
+		},
+	}
+	for _, tc := range tests {
+		got := parse(tc.input)
+		if got != tc.want {
+			t.Errorf("parse got %v, want %v", got, tc.want)
+		}
+	}
+}
+			`[1:],
+			wantBlock: func(funcDecl *ast.FuncDecl) *ast.BlockStmt {
+				return funcDecl.Body.List[1].(*ast.RangeStmt).Body
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			fset := token.NewFileSet()
-
-			node, err := parser.ParseFile(fset, "test.go", tc.content, parser.ParseComments)
-			if err != nil {
-				t.Fatalf("error parsing file: %v", err)
-			}
+			node, info := typeCheck(t, tc.content)
 
 			ast.Inspect(node, func(n ast.Node) bool {
 				if funcDecl, ok := n.(*ast.FuncDecl); ok {
-					got := newTableDrivenInfo("t", funcDecl)
+					got := newTableDrivenInfo(info, NewPackageDecls(info, []*ast.File{node}), funcDecl.Body)
 
 					gotBlock := got.Block
 					if tc.wantBlock != nil && !cmp.Equal(gotBlock, tc.wantBlock(funcDecl)) {
@@ -172,11 +242,58 @@ func TestNewTableDrivenTestInfoNoTableDrivenTest(t *testing.T) {
 			content: `
 package main
 
+import "testing"
+
 func TestExample(t *testing.T) {
   got := parse("1")
   if got != tc.want {
     t.Errorf("parse got %v, want %v", got, tc.want)
   }
+}
+			`[1:],
+		},
+		"range over values without subtests": {
+			content: `
+package main
+
+import "testing"
+
+func TestExample(t *testing.T) {
+	for _, input := range []string{"1", "2"} {
+		if parse(input) == 0 {
+			t.Errorf("parse(%v) = 0", input)
+		}
+	}
+}
+			`[1:],
+		},
+		"range with two t.Run": {
+			content: `
+package main
+
+import "testing"
+
+func TestExample(t *testing.T) {
+	tests := map[string]string{"example": "1"}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {})
+		t.Run(name+" again", func(t *testing.T) {})
+	}
+}
+			`[1:],
+		},
+		"range with other statements": {
+			content: `
+package main
+
+import "testing"
+
+func TestExample(t *testing.T) {
+	tests := map[string]string{"example": "1"}
+	for name, input := range tests {
+		got := parse(input)
+		t.Run(name, func(t *testing.T) {})
+	}
 }
 			`[1:],
 		},
@@ -185,16 +302,11 @@ func TestExample(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			fset := token.NewFileSet()
-
-			node, err := parser.ParseFile(fset, "test.go", tc.content, parser.ParseComments)
-			if err != nil {
-				t.Fatalf("error parsing file: %v", err)
-			}
+			node, info := typeCheck(t, tc.content)
 
 			ast.Inspect(node, func(n ast.Node) bool {
 				if funcDecl, ok := n.(*ast.FuncDecl); ok {
-					got := newTableDrivenInfo("t", funcDecl)
+					got := newTableDrivenInfo(info, NewPackageDecls(info, []*ast.File{node}), funcDecl.Body)
 					if got != nil {
 						t.Errorf("newTableDrivenInfo() = %v, want nil", got)
 					}
@@ -205,3 +317,28 @@ func TestExample(t *testing.T) {
 		})
 	}
 }
+
+// typeCheck parses and type checks the content, the type errors (e.g. undeclared functions) are ignored.
+func typeCheck(t *testing.T, content string) (*ast.File, *types.Info) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+
+	node, err := parser.ParseFile(fset, "test.go", content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("error parsing file: %v", err)
+	}
+
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{
+		Importer: importer.Default(),
+		Error:    func(error) {},
+	}
+	_, _ = conf.Check("main", fset, []*ast.File{node}, info)
+
+	return node, info
+}
@@ -0,0 +1,233 @@
+package model
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// testedCallStmt returns the statement, among the statements that precede the comparison, that calls the
+// tested function whose result is compared. The variables of the got operand are looked for first, in order, and
+// the variables of the other operand only if no call produces the got operand.
+// It's a heuristic over the statements of a single block, so nil is returned when a variable is assigned in a nested
+// block, like an if or a for statement, since the assignment that reaches the comparison can't be known.
+func testedCallStmt(info *types.Info, stmts []ast.Stmt, gotIdents, otherIdents []*ast.Ident) ast.Stmt {
+	for _, idents := range [][]*ast.Ident{gotIdents, otherIdents} {
+		for _, ident := range idents {
+			stmt, known := reachingCallStmt(info, stmts, ident)
+			if !known {
+				return nil
+			}
+
+			if stmt != nil {
+				return stmt
+			}
+		}
+	}
+
+	return nil
+}
+
+// TestedCallExprOf returns the call to the tested function that produced the variable, looked for in the statements
+// that precede its use.
+func TestedCallExprOf(info *types.Info, stmts []ast.Stmt, ident *ast.Ident) (TestedCallExpr, bool) {
+	stmt, known := reachingCallStmt(info, stmts, ident)
+	if !known {
+		return TestedCallExpr{}, false
+	}
+
+	return NewTestedCallExpr(stmt)
+}
+
+// comparedOperands returns the variables of the got operand, and of the other operand, compared in the if condition,
+// either with !=, !cmp.Equal, !reflect.DeepEqual or the cmp.Diff call of the if init.
+// If the condition is not a comparison of two operands, all its variables are returned as got.
+func comparedOperands(info *types.Info, ifStmt *ast.IfStmt) ([]*ast.Ident, []*ast.Ident) {
+	if _, diffCallExpr, isDiff := diffParamIfStmt(info, ifStmt); isDiff {
+		got, want := gotFirst(diffCallExpr.Args[1], diffCallExpr.Args[0])
+
+		return varIdents(info, got), varIdents(info, want)
+	}
+
+	switch node := ifStmt.Cond.(type) {
+	case *ast.BinaryExpr:
+		if node.Op == token.NEQ {
+			got, want := gotFirst(node.X, node.Y)
+
+			return varIdents(info, got), varIdents(info, want)
+		}
+	case *ast.UnaryExpr:
+		callExpr, isCallExpr := node.X.(*ast.CallExpr)
+		if node.Op == token.NOT && isCallExpr && len(callExpr.Args) == 2 &&
+			(IsGoCmpEqual(info, callExpr) || IsReflectDeepEqual(info, callExpr)) {
+			got, want := gotFirst(callExpr.Args[0], callExpr.Args[1])
+
+			return varIdents(info, got), varIdents(info, want)
+		}
+	}
+
+	return varIdents(info, ifStmt.Cond), nil
+}
+
+// gotFirst returns the operands, given in their usual order, the got operand first, unless their names say
+// otherwise, like in `want != got`.
+func gotFirst(got, want ast.Expr) (ast.Expr, ast.Expr) {
+	if wantParamRegexp.MatchString(operandName(got)) || gotParamRegexp.MatchString(operandName(want)) {
+		return want, got
+	}
+
+	return got, want
+}
+
+// operandName returns the name of the variable or the field of the operand, empty for other expressions.
+func operandName(expr ast.Expr) string {
+	switch node := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return node.Name
+	case *ast.SelectorExpr:
+		return node.Sel.Name
+	default:
+		return ""
+	}
+}
+
+// splitByName returns the variables whose names don't look like an expected value first, and then the others,
+// like want or expected.
+func splitByName(idents []*ast.Ident) ([]*ast.Ident, []*ast.Ident) {
+	got, want := make([]*ast.Ident, 0, len(idents)), make([]*ast.Ident, 0)
+
+	for _, ident := range idents {
+		if wantParamRegexp.MatchString(ident.Name) {
+			want = append(want, ident)
+		} else {
+			got = append(got, ident)
+		}
+	}
+
+	return got, want
+}
+
+// varIdents returns the identifiers of variables found in the nodes.
+func varIdents(info *types.Info, nodes ...ast.Node) []*ast.Ident {
+	idents := make([]*ast.Ident, 0)
+
+	for _, node := range nodes {
+		ast.Inspect(node, func(n ast.Node) bool {
+			ident, isIdent := n.(*ast.Ident)
+			if !isIdent {
+				return true
+			}
+
+			if _, isVar := info.ObjectOf(ident).(*types.Var); isVar {
+				idents = append(idents, ident)
+			}
+
+			return true
+		})
+	}
+
+	return idents
+}
+
+// reachingCallStmt walks the statements backwards and returns the closest assignment of the variable that calls a
+// function, or nil if the variable is not assigned by a function call.
+// Assignments that transform the variable, like `got = normalize(got)`, are skipped, so the call that originally
+// produced the value is returned.
+// The assignments nested in other statements, like in the body of a for statement, may not be executed, so it returns
+// false unless all of them call the same function than the closest assignment.
+func reachingCallStmt(info *types.Info, stmts []ast.Stmt, ident *ast.Ident) (ast.Stmt, bool) {
+	nestedFuncs := make(map[string]bool)
+
+	for i := len(stmts) - 1; i >= 0; i-- {
+		assignStmt, isAssignStmt := stmts[i].(*ast.AssignStmt)
+		if !isAssignStmt {
+			for _, nested := range nestedAssignments(info, stmts[i], ident) {
+				callExpr, isCall := assignedCallExpr(nested)
+				if !isCall {
+					return nil, false
+				}
+
+				if usesIdent(info, callExpr.Args, ident) {
+					continue
+				}
+
+				nestedFuncs[types.ExprString(callExpr.Fun)] = true
+			}
+
+			continue
+		}
+
+		if !assignsIdent(info, assignStmt, ident) {
+			continue
+		}
+
+		callExpr, isCall := assignedCallExpr(assignStmt)
+		if !isCall {
+			return nil, len(nestedFuncs) == 0
+		}
+
+		if usesIdent(info, callExpr.Args, ident) {
+			continue
+		}
+
+		delete(nestedFuncs, types.ExprString(callExpr.Fun))
+
+		return assignStmt, len(nestedFuncs) == 0
+	}
+
+	return nil, len(nestedFuncs) == 0
+}
+
+// assignedCallExpr returns the function call of the assignment, if it has a single call in its right hand side.
+func assignedCallExpr(assignStmt *ast.AssignStmt) (*ast.CallExpr, bool) {
+	if len(assignStmt.Rhs) != 1 {
+		return nil, false
+	}
+
+	callExpr, isCallExpr := assignStmt.Rhs[0].(*ast.CallExpr)
+
+	return callExpr, isCallExpr
+}
+
+// nestedAssignments returns the assignments of the variable that are nested in the statement.
+func nestedAssignments(info *types.Info, stmt ast.Stmt, ident *ast.Ident) []*ast.AssignStmt {
+	assignStmts := make([]*ast.AssignStmt, 0)
+
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if assignStmt, isAssignStmt := n.(*ast.AssignStmt); isAssignStmt && assignsIdent(info, assignStmt, ident) {
+			assignStmts = append(assignStmts, assignStmt)
+		}
+
+		return true
+	})
+
+	return assignStmts
+}
+
+// assignsIdent returns whether the variable is in the left hand side of the assignment.
+func assignsIdent(info *types.Info, assignStmt *ast.AssignStmt, ident *ast.Ident) bool {
+	for _, lhs := range assignStmt.Lhs {
+		if lhsIdent, isIdent := isNotBlankIdent(lhs); isIdent && isSameObject(info, lhsIdent, ident) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// usesIdent returns whether the variable is used in any of the expressions.
+func usesIdent(info *types.Info, exprs []ast.Expr, ident *ast.Ident) bool {
+	found := false
+
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if other, isIdent := n.(*ast.Ident); isIdent && isSameObject(info, other, ident) {
+				found = true
+			}
+
+			return !found
+		})
+	}
+
+	return found
+}
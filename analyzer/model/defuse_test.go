@@ -0,0 +1,216 @@
+package model
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTestPartBlocksTestedFunctionNames(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content string
+		want    []string
+	}{
+		"previous statement": {
+			content: `
+package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func TestExample(t *testing.T) {
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+			`[1:],
+			want: []string{"double"},
+		},
+		"error checked and value normalized": {
+			content: `
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	want := "a"
+	got, err := strconv.Unquote("\"a\"")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got = strings.TrimSpace(got)
+	if got != want {
+		t.Errorf("strconv.Unquote() = %v, want %v", got, want)
+	}
+}
+			`[1:],
+			want: []string{"strconv.Unquote"},
+		},
+		"several outputs compared in a row": {
+			content: `
+package main
+
+import "testing"
+
+func divMod(a, b int) (int, int) { return a / b, a % b }
+
+func TestExample(t *testing.T) {
+	wantDiv, wantMod := 2, 1
+	gotDiv, gotMod := divMod(5, 2)
+	if gotDiv != wantDiv {
+		t.Errorf("divMod() = %v, want %v", gotDiv, wantDiv)
+	}
+	if gotMod != wantMod {
+		t.Errorf("divMod() = %v, want %v", gotMod, wantMod)
+	}
+}
+			`[1:],
+			want: []string{"divMod", "divMod"},
+		},
+		"value reassigned without a call": {
+			content: `
+package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func TestExample(t *testing.T) {
+	want := 3
+	got := double(1)
+	got++
+	got = got + 1
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+			`[1:],
+			want: []string{},
+		},
+		"want produced by a call after the tested call": {
+			content: `
+package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func makeWant(a int) int { return a + a }
+
+func TestExample(t *testing.T) {
+	in := 1
+	got := double(in)
+	want := makeWant(in)
+	if got != want {
+		t.Errorf("double(%v) = %v, want %v", in, got, want)
+	}
+	if want != got {
+		t.Errorf("double(%v) = %v, want %v", in, got, want)
+	}
+}
+			`[1:],
+			want: []string{"double", "double"},
+		},
+		"got not produced by a call": {
+			content: `
+package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func TestExample(t *testing.T) {
+	got := 2
+	want := double(1)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+			`[1:],
+			want: []string{"double"},
+		},
+		"got reassigned inside an if": {
+			content: `
+package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func makeWant(a int) int { return a + a }
+
+func TestExample(t *testing.T) {
+	want := makeWant(1)
+	got := 0
+	if want > 0 {
+		got = double(1)
+	}
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+			`[1:],
+			want: []string{},
+		},
+		"got reassigned inside a for with the same call": {
+			content: `
+package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func TestExample(t *testing.T) {
+	want := 2
+	got := double(1)
+	for range 3 {
+		got = double(1)
+	}
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+			`[1:],
+			want: []string{"double"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			node, info := typeCheck(t, test.content)
+
+			got := make([]string, 0)
+
+			for _, decl := range node.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+				if !ok {
+					continue
+				}
+
+				testFunc, ok := NewTestFunction(info, NewPackageDecls(info, []*ast.File{node}), funcDecl)
+				if !ok {
+					continue
+				}
+
+				for _, testBlock := range testFunc.TestPartBlocks() {
+					got = append(got, testBlock.TestedFunc().FunctionName())
+				}
+			}
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("TestPartBlocks() tested functions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// HelperFactOf returns the HelperFact of the function, if it's a helper that compares values.
+type HelperFactOf func(fn *types.Func) (*HelperFact, bool)
+
+// HelperCallBlock is a call to a helper that compares the result of the tested function, like:
+//
+//	got := MyFunction(in)
+//	testutil.AssertEqual(t, got, want)
+type HelperCallBlock struct {
+	// testedFunc contain the actual call to the function tested.
+	testedFunc TestedCallExpr
+
+	// callExpr is the call to the helper.
+	callExpr *ast.CallExpr
+
+	// fact describes the parameters of the helper.
+	fact *HelperFact
+}
+
+// TestedFunc returns the call to the tested function.
+func (h HelperCallBlock) TestedFunc() TestedCallExpr {
+	return h.testedFunc
+}
+
+// CallExpr returns the call to the helper.
+func (h HelperCallBlock) CallExpr() *ast.CallExpr {
+	return h.callExpr
+}
+
+// Fact returns the HelperFact of the called helper.
+func (h HelperCallBlock) Fact() *HelperFact {
+	return h.fact
+}
+
+// GotArg returns the argument passed as the got parameter.
+func (h HelperCallBlock) GotArg() ast.Expr {
+	return h.callExpr.Args[h.fact.GotIndex]
+}
+
+// WantArg returns the argument passed as the want parameter.
+func (h HelperCallBlock) WantArg() ast.Expr {
+	return h.callExpr.Args[h.fact.WantIndex]
+}
+
+// MessageArg returns the argument passed as the failure message, if the helper accepts one and it's passed.
+func (h HelperCallBlock) MessageArg() (ast.Expr, bool) {
+	if h.fact.MessageIndex < 0 || h.fact.MessageIndex >= len(h.callExpr.Args) {
+		return nil, false
+	}
+
+	return h.callExpr.Args[h.fact.MessageIndex], true
+}
+
+// newHelperCallBlock creates a HelperCallBlock if the statement is a call to a helper that compares values,
+// the tested function is looked for in the previous statements.
+func newHelperCallBlock(
+	info *types.Info,
+	factOf HelperFactOf,
+	prevStmts []ast.Stmt,
+	stmt ast.Stmt,
+) (HelperCallBlock, bool) {
+	exprStmt, isExprStmt := stmt.(*ast.ExprStmt)
+	if !isExprStmt {
+		return HelperCallBlock{}, false
+	}
+
+	callExpr, isCallExpr := exprStmt.X.(*ast.CallExpr)
+	if !isCallExpr {
+		return HelperCallBlock{}, false
+	}
+
+	fn := typeutil.StaticCallee(info, callExpr)
+	if fn == nil {
+		return HelperCallBlock{}, false
+	}
+
+	fact, isHelper := factOf(fn)
+	if !isHelper || len(callExpr.Args) <= max(fact.GotIndex, fact.WantIndex) {
+		return HelperCallBlock{}, false
+	}
+
+	gotArg, wantArg := callExpr.Args[fact.GotIndex], callExpr.Args[fact.WantIndex]
+
+	testedStmt := testedCallStmt(info, prevStmts, varIdents(info, gotArg), varIdents(info, wantArg))
+
+	testedFunc, isTestedFunc := NewTestedCallExpr(testedStmt)
+	if !isTestedFunc {
+		return HelperCallBlock{}, false
+	}
+
+	return HelperCallBlock{
+		testedFunc: testedFunc,
+		callExpr:   callExpr,
+		fact:       fact,
+	}, true
+}
@@ -0,0 +1,197 @@
+package model
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+var _ analysis.Fact = new(HelperFact)
+
+// HelperFact is exported for the functions that compare two of their parameters and report the failure through a
+// testing handle, like:
+//
+//	func AssertEqual(t testing.TB, got, want any) {
+//		t.Helper()
+//
+//		if !cmp.Equal(got, want) {
+//			t.Errorf("got %v, want %v", got, want)
+//		}
+//	}
+//
+// The fact is shared with the packages that import the helper, so their calls to it are understood as comparisons.
+type HelperFact struct {
+	// GotIndex is the index of the parameter that receives the result of the tested function.
+	GotIndex int
+	// WantIndex is the index of the parameter that receives the expected result.
+	WantIndex int
+	// MessageIndex is the index of the parameter that receives the failure message, or -1 if there is none.
+	MessageIndex int
+	// CallsHelper is true when the first statement of the helper is a call to t.Helper().
+	CallsHelper bool
+}
+
+// AFact marks HelperFact as an analysis.Fact.
+func (*HelperFact) AFact() {}
+
+func (f *HelperFact) String() string {
+	return fmt.Sprintf("helper(got=%d, want=%d, message=%d, callsHelper=%t)",
+		f.GotIndex, f.WantIndex, f.MessageIndex, f.CallsHelper)
+}
+
+var (
+	gotParamRegexp  = regexp.MustCompile(`(?i)^(got|actual|have|result)`)
+	wantParamRegexp = regexp.MustCompile(`(?i)^(want|expect(ed)?|exp)`)
+)
+
+// NewHelperFact creates the HelperFact of the function declaration, if the function is a helper that compares two of
+// its parameters, with !=, !cmp.Equal, !reflect.DeepEqual or cmp.Diff, and reports the failure through its testing
+// handle.
+// The got and want parameters are identified by their names (got, actual, want, expected...), and if the names
+// are not conclusive, by the usual order, `got != want`, `cmp.Equal(got, want)` and `cmp.Diff(want, got)`.
+func NewHelperFact(info *types.Info, funcDecl *ast.FuncDecl) (*HelperFact, bool) {
+	if funcDecl.Recv != nil || funcDecl.Body == nil || isTestEntryPoint(info, funcDecl) {
+		return nil, false
+	}
+
+	fn, ok := info.Defs[funcDecl.Name].(*types.Func)
+	if !ok {
+		return nil, false
+	}
+
+	testHelper, ok := newTestHelper(info, funcDecl.Type, funcDecl.Body)
+	if !ok {
+		return nil, false
+	}
+
+	//nolint:errcheck // the type of a function is always a signature
+	signature := fn.Type().(*types.Signature)
+
+	var fact *HelperFact
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		ifStmt, isIfStmt := n.(*ast.IfStmt)
+		if fact != nil || !isIfStmt {
+			return fact == nil
+		}
+
+		x, y, isDiff, isComparison := comparedParams(info, ifStmt)
+		if !isComparison || !testHelper.reportsFailures(ifStmt.Body) {
+			return true
+		}
+
+		xIndex, yIndex := paramIndex(info, signature, x), paramIndex(info, signature, y)
+		if xIndex == -1 || yIndex == -1 {
+			return true
+		}
+
+		gotIndex, wantIndex := xIndex, yIndex
+		if isDiff {
+			gotIndex, wantIndex = yIndex, xIndex
+		}
+
+		switch {
+		case gotParamRegexp.MatchString(x.Name) || wantParamRegexp.MatchString(y.Name):
+			gotIndex, wantIndex = xIndex, yIndex
+		case wantParamRegexp.MatchString(x.Name) || gotParamRegexp.MatchString(y.Name):
+			gotIndex, wantIndex = yIndex, xIndex
+		}
+
+		messageIndex := messageParamIndex(signature)
+		if messageIndex == gotIndex || messageIndex == wantIndex {
+			messageIndex = -1
+		}
+
+		fact = &HelperFact{
+			GotIndex:     gotIndex,
+			WantIndex:    wantIndex,
+			MessageIndex: messageIndex,
+			CallsHelper:  testHelper.CallsHelper(),
+		}
+
+		return false
+	})
+
+	return fact, fact != nil
+}
+
+// comparedParams returns the two identifiers compared in the if statement, and whether they are compared with cmp.Diff.
+func comparedParams(info *types.Info, ifStmt *ast.IfStmt) (*ast.Ident, *ast.Ident, bool, bool) {
+	var (
+		args   []ast.Expr
+		isDiff bool
+	)
+
+	switch node := ifStmt.Cond.(type) {
+	case *ast.BinaryExpr:
+		if _, diffCallExpr, isDiffParam := diffParamIfStmt(info, ifStmt); isDiffParam {
+			args = diffCallExpr.Args[:2]
+			isDiff = true
+		} else if node.Op == token.NEQ && !isNil(info, node.X) && !isNil(info, node.Y) {
+			args = []ast.Expr{node.X, node.Y}
+		}
+	case *ast.UnaryExpr:
+		callExpr, isCallExpr := node.X.(*ast.CallExpr)
+		if node.Op == token.NOT && isCallExpr && (IsGoCmpEqual(info, callExpr) || IsReflectDeepEqual(info, callExpr)) {
+			args = callExpr.Args
+		}
+	}
+
+	if len(args) != 2 {
+		return nil, nil, false, false
+	}
+
+	x, isXIdent := isNotBlankIdent(args[0])
+	y, isYIdent := isNotBlankIdent(args[1])
+
+	return x, y, isDiff, isXIdent && isYIdent
+}
+
+// paramIndex returns the index of the parameter of the signature that the identifier refers to, or -1.
+func paramIndex(info *types.Info, signature *types.Signature, ident *ast.Ident) int {
+	obj := info.ObjectOf(ident)
+
+	for i := range signature.Params().Len() {
+		if signature.Params().At(i) == obj {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// messageParamIndex returns the index of the parameter that receives the failure message, or -1.
+// It's either a string followed by the variadic arguments, like `format string, args ...any`, the variadic arguments
+// themselves, like `msgAndArgs ...any`, or a trailing string.
+func messageParamIndex(signature *types.Signature) int {
+	params := signature.Params()
+	last := params.Len() - 1
+
+	if last < 0 {
+		return -1
+	}
+
+	isString := func(i int) bool {
+		basic, ok := types.Unalias(params.At(i).Type()).(*types.Basic)
+
+		return ok && basic.Kind() == types.String
+	}
+
+	if !signature.Variadic() {
+		if isString(last) {
+			return last
+		}
+
+		return -1
+	}
+
+	if last > 0 && isString(last-1) {
+		return last - 1
+	}
+
+	return last
+}
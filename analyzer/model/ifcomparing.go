@@ -3,6 +3,7 @@ package model
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 var (
@@ -20,6 +21,7 @@ type (
 	// 1. if param1 != param2
 	// 2. if !reflect.DeepEqual(param1, param2)
 	// 3. if !cmp.Equal(param1, param2).
+	// The param1 can also be declared in the if init, like in if got := f(x); got != want.
 	ComparingParamsIfStmt struct {
 		ifStmt *ast.IfStmt
 
@@ -33,12 +35,19 @@ type (
 	// if diff := cmp.Diff(param1, param2); diff != "".
 	DiffIfStmt struct {
 		ifStmt *ast.IfStmt
+
+		// diff identifier that holds the result of cmp.Diff
+		diff *ast.Ident
+		// x first argument of cmp.Diff, the value whose lines are prefixed with "-"
+		x ast.Expr
+		// y second argument of cmp.Diff, the value whose lines are prefixed with "+"
+		y ast.Expr
 	}
 )
 
 // NewIfComparingResult creates a new IfComparingResult based on the if condition.
 func NewIfComparingResult(
-	importGroup ImportGroup,
+	info *types.Info,
 	testedFunctionParams []*ast.Ident,
 	ifStmt *ast.IfStmt,
 ) (IfComparing, bool) {
@@ -46,32 +55,26 @@ func NewIfComparingResult(
 		return nil, false
 	}
 
-	if len(ifStmt.Body.List) != 1 {
-		return nil, false
-	}
-
-	if ifStmt.Init == nil {
-		// case got != equal and !reflect.DeepEqual or !cmp.Equal
-		got, want, ok := getGotWantParams(importGroup, testedFunctionParams, ifStmt.Cond)
-		if !ok {
-			return nil, false
-		}
-
-		return ComparingParamsIfStmt{
+	// case cmp.Diff
+	if diff, diffCallExpr, isDiff := diffParamIfStmt(info, ifStmt); isDiff {
+		return DiffIfStmt{
 			ifStmt: ifStmt,
-			got:    got,
-			want:   want,
+			diff:   diff,
+			x:      diffCallExpr.Args[0],
+			y:      diffCallExpr.Args[1],
 		}, true
 	}
 
-	// case cmp.Diff
-	ok := isDiffParamIfStmt(importGroup, ifStmt)
+	// case got != equal and !reflect.DeepEqual or !cmp.Equal, the got param can be declared in the if init
+	got, want, ok := getGotWantParams(info, testedFunctionParams, ifStmt.Cond)
 	if !ok {
 		return nil, false
 	}
 
-	return DiffIfStmt{
+	return ComparingParamsIfStmt{
 		ifStmt: ifStmt,
+		got:    got,
+		want:   want,
 	}, true
 }
 
@@ -91,79 +94,100 @@ func (d DiffIfStmt) IfStmt() *ast.IfStmt {
 	return d.ifStmt
 }
 
+// Diff returns the identifier that holds the result of cmp.Diff.
+func (d DiffIfStmt) Diff() *ast.Ident {
+	return d.diff
+}
+
+// X returns the first argument of cmp.Diff, whose lines are prefixed with "-" in the diff.
+func (d DiffIfStmt) X() ast.Expr {
+	return d.x
+}
+
+// Y returns the second argument of cmp.Diff, whose lines are prefixed with "+" in the diff.
+func (d DiffIfStmt) Y() ast.Expr {
+	return d.y
+}
+
+func isDiffParamIfStmt(info *types.Info, ifStmt *ast.IfStmt) bool {
+	_, _, ok := diffParamIfStmt(info, ifStmt)
+
+	return ok
+}
+
+// diffParamIfStmt returns the identifier that holds the result of cmp.Diff and the call to cmp.Diff
+// if the if statement is like `if diff := cmp.Diff(param1, param2); diff != ""`, with or without options.
+//
 //nolint:gocognit // refactor later
-func isDiffParamIfStmt(importGroup ImportGroup, ifStmt *ast.IfStmt) bool {
-	var diffParam *ast.Ident
+func diffParamIfStmt(info *types.Info, ifStmt *ast.IfStmt) (*ast.Ident, *ast.CallExpr, bool) {
+	var (
+		diffParam    *ast.Ident
+		diffCallExpr *ast.CallExpr
+	)
 
 	switch node := ifStmt.Init.(type) {
 	case *ast.AssignStmt:
 		if len(node.Lhs) != 1 {
-			return false
+			return nil, nil, false
 		}
 
 		ident, ok := node.Lhs[0].(*ast.Ident)
 		if !ok {
-			return false
+			return nil, nil, false
 		}
 
 		if len(node.Rhs) != 1 {
-			return false
+			return nil, nil, false
 		}
 
 		callExpr, ok := node.Rhs[0].(*ast.CallExpr)
 		if !ok {
-			return false
-		}
-
-		if len(callExpr.Args) != 2 {
-			return false
+			return nil, nil, false
 		}
 
-		selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
-		if !ok {
-			return false
+		if len(callExpr.Args) < 2 {
+			return nil, nil, false
 		}
 
-		goCmpImportAlias, _ := importGroup.GoCmpImportName()
-
-		if !isGoCmpDiff(goCmpImportAlias, selectorExpr) {
-			return false
+		if !IsGoCmpDiff(info, callExpr) {
+			return nil, nil, false
 		}
 
 		diffParam = ident
+		diffCallExpr = callExpr
 	default:
-		return false
+		return nil, nil, false
 	}
 
 	switch node := ifStmt.Cond.(type) {
 	case *ast.BinaryExpr:
 		// check "ident1 != ident2" and both are used in the failure message `t.Errorf`.
 		if node.Op != token.NEQ {
-			return false
+			return nil, nil, false
 		}
 
 		xIdent, isXIdent := isNotBlankIdent(node.X)
 		if !isXIdent {
-			return false
+			return nil, nil, false
 		}
 
 		if basicLit, isBasicLit := node.Y.(*ast.BasicLit); !isBasicLit || basicLit.Value != "\"\"" {
-			return false
+			return nil, nil, false
 		}
 
-		if xIdent.Name != diffParam.Name {
-			return false
+		if !isSameObject(info, xIdent, diffParam) {
+			return nil, nil, false
 		}
 	default:
-		return false
+		return nil, nil, false
 	}
 
-	return true
+	return diffParam, diffCallExpr, true
 }
 
 //nolint:gocognit // refactor later
 func getGotWantParams(
-	importGroup ImportGroup,
+	info *types.Info,
 	testedFunctionParams []*ast.Ident,
 	cond ast.Expr,
 ) (*ast.Ident, ast.Expr, bool) {
@@ -174,22 +198,22 @@ func getGotWantParams(
 			return nil, nil, false
 		}
 
-		xIdent, isXIdent := isNotBlankIdent(node.X)
-
-		yIdent, isYIdent := isNotBlankIdent(node.Y)
-		if isYIdent && yIdent.Name == "nil" {
+		if isNil(info, node.X) || isNil(info, node.Y) {
 			return nil, nil, false
 		}
 
+		xIdent, isXIdent := isNotBlankIdent(node.X)
+		yIdent, isYIdent := isNotBlankIdent(node.Y)
+
 		for _, p := range testedFunctionParams {
-			if isXIdent && p.Name == xIdent.Name {
+			if isXIdent && isSameObject(info, p, xIdent) {
 				got := xIdent
 				want := node.Y
 
 				return got, want, true
 			}
 
-			if isYIdent && p.Name == yIdent.Name {
+			if isYIdent && isSameObject(info, p, yIdent) {
 				got := yIdent
 				want := node.X
 
@@ -214,27 +238,19 @@ func getGotWantParams(
 		xIdent, isXIdent := isNotBlankIdent(callExpr.Args[0])
 		yIdent, isYIdent := isNotBlankIdent(callExpr.Args[1])
 
-		selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
-		if !ok {
-			return nil, nil, false
-		}
-
-		goCmpImportAlias, _ := importGroup.GoCmpImportName()
-		reflectImportAlias, _ := importGroup.ReflectImportName()
-
-		if !isGoCmpEqual(goCmpImportAlias, selectorExpr) && !isReflectEqual(reflectImportAlias, selectorExpr) {
+		if !IsGoCmpEqual(info, callExpr) && !IsReflectDeepEqual(info, callExpr) {
 			return nil, nil, false
 		}
 
 		for _, p := range testedFunctionParams {
-			if isXIdent && p.Name == xIdent.Name {
+			if isXIdent && isSameObject(info, p, xIdent) {
 				got := xIdent
 				want := callExpr.Args[1]
 
 				return got, want, true
 			}
 
-			if isYIdent && p.Name == yIdent.Name {
+			if isYIdent && isSameObject(info, p, yIdent) {
 				got := yIdent
 				want := callExpr.Args[0]
 
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// PackageDecls contains the package-level declarations of a package, so the tables of test cases declared outside
+// the test functions, like in a package variable or returned by a function, can be resolved.
+type PackageDecls struct {
+	// vars contains the values of the package-level variables.
+	vars map[types.Object]ast.Expr
+
+	// funcs contains the declarations of the package-level functions.
+	funcs map[types.Object]*ast.FuncDecl
+}
+
+// NewPackageDecls creates the PackageDecls with the declarations of the files.
+func NewPackageDecls(info *types.Info, files []*ast.File) PackageDecls {
+	decls := PackageDecls{
+		vars:  make(map[types.Object]ast.Expr),
+		funcs: make(map[types.Object]*ast.FuncDecl),
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch node := decl.(type) {
+			case *ast.FuncDecl:
+				if obj := info.Defs[node.Name]; obj != nil && node.Recv == nil {
+					decls.funcs[obj] = node
+				}
+			case *ast.GenDecl:
+				if node.Tok != token.VAR {
+					continue
+				}
+
+				for _, spec := range node.Specs {
+					valueSpec, isValueSpec := spec.(*ast.ValueSpec)
+					if !isValueSpec || len(valueSpec.Names) != len(valueSpec.Values) {
+						continue
+					}
+
+					for i, name := range valueSpec.Names {
+						if obj := info.Defs[name]; obj != nil {
+							decls.vars[obj] = valueSpec.Values[i]
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return decls
+}
+
+// returnedTable returns the table of test cases returned by the function called, either as a composite literal, like
+// in `return []testCase{...}`, or as a variable assigned before, like in `tests := []testCase{...}; return tests`.
+func (d PackageDecls) returnedTable(info *types.Info, callExpr *ast.CallExpr) (*ast.CompositeLit, bool) {
+	fn := typeutil.StaticCallee(info, callExpr)
+	if fn == nil {
+		return nil, false
+	}
+
+	funcDecl, ok := d.funcs[fn]
+	if !ok || funcDecl.Body == nil || len(funcDecl.Body.List) == 0 {
+		return nil, false
+	}
+
+	returnStmt, isReturnStmt := funcDecl.Body.List[len(funcDecl.Body.List)-1].(*ast.ReturnStmt)
+	if !isReturnStmt || len(returnStmt.Results) != 1 {
+		return nil, false
+	}
+
+	switch result := returnStmt.Results[0].(type) {
+	case *ast.CompositeLit:
+		compositeLit := isMapOrSliceCompositeLit(result)
+
+		return compositeLit, compositeLit != nil
+	case *ast.Ident:
+		for _, stmt := range funcDecl.Body.List {
+			assignStmt, isAssignStmt := stmt.(*ast.AssignStmt)
+			if !isAssignStmt || len(assignStmt.Lhs) != 1 || len(assignStmt.Rhs) != 1 {
+				continue
+			}
+
+			ident, isIdent := assignStmt.Lhs[0].(*ast.Ident)
+			if !isIdent || info.ObjectOf(ident) != info.ObjectOf(result) {
+				continue
+			}
+
+			if compositeLit := isMapOrSliceCompositeLit(assignStmt.Rhs[0]); compositeLit != nil {
+				return compositeLit, true
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -1,77 +1,175 @@
 package model
 
-import "go/ast"
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+type (
+	// TErrorfCallExpr contains the call that reports the failure and its parameters.
+	// The supported reporters are t.Errorf, t.Error, t.Fatalf and t.Fatal, and t.Logf or t.Log followed by
+	// t.Fail or t.FailNow.
+	TErrorfCallExpr struct {
+		callExpr *ast.CallExpr
+		// failCallExpr is the call to t.Fail or t.FailNow when the message is reported with t.Log or t.Logf,
+		// nil otherwise.
+		failCallExpr   *ast.CallExpr
+		failureMessage string
+		kind           ReportKind
+	}
 
-// TErrorfCallExpr contains the call to t.Errorf and its parameters.
-type TErrorfCallExpr struct {
-	callExpr       *ast.CallExpr
-	failureMessage string
-}
+	// ReportKind describes how a failure is reported.
+	ReportKind struct {
+		// Fatal is true when the test stops after reporting the failure (t.Fatal, t.Fatalf or t.FailNow).
+		Fatal bool
+		// Formatted is true when the first argument is a format string (t.Errorf, t.Fatalf or t.Logf).
+		Formatted bool
+	}
+)
+
+//nolint:gochecknoglobals // read-only lookup tables
+var (
+	// reportMethods contains the testing.TB methods that log a message and mark the test as failed.
+	reportMethods = map[string]ReportKind{
+		"Errorf": {Fatal: false, Formatted: true},
+		"Error":  {Fatal: false, Formatted: false},
+		"Fatalf": {Fatal: true, Formatted: true},
+		"Fatal":  {Fatal: true, Formatted: false},
+	}
+	// logMethods contains the testing.TB methods that only log a message, and whether they are formatted.
+	logMethods = map[string]bool{
+		"Logf": true,
+		"Log":  false,
+	}
+	// failMethods contains the testing.TB methods that only mark the test as failed, and whether they are fatal.
+	failMethods = map[string]bool{
+		"Fail":    false,
+		"FailNow": true,
+	}
+)
 
-// NewTErrorfCallExpr creates a tErrorfCallExpr after checking that the stmt is a call to t.Errorf.
-func NewTErrorfCallExpr(testVar string, blStmts *ast.BlockStmt) (TErrorfCallExpr, bool) {
+// NewTErrorfCallExprs creates the tErrorfCallExprs for the calls that report a failure in the block,
+// either calls to t.Errorf, t.Error, t.Fatalf or t.Fatal, or t.Logf or t.Log followed by t.Fail or t.FailNow.
+// The reporters can be found anywhere in the block, surrounded by other statements like logging extra context,
+// computing a diff or a trailing return.
+func NewTErrorfCallExprs(info *types.Info, blStmts *ast.BlockStmt) ([]TErrorfCallExpr, bool) {
 	if blStmts == nil {
-		return TErrorfCallExpr{}, false
+		return nil, false
 	}
 
-	if len(blStmts.List) != 1 {
-		return TErrorfCallExpr{}, false
-	}
+	reporters := make([]TErrorfCallExpr, 0)
 
-	stmt := blStmts.List[0]
+	// logCallExpr is the last call to t.Logf or t.Log, that reports the failure if t.Fail or t.FailNow follows.
+	var logCallExpr *ast.CallExpr
 
-	exprStmt, isExprStmt := stmt.(*ast.ExprStmt)
-	if !isExprStmt {
-		return TErrorfCallExpr{}, false
-	}
+	logFormatted := false
 
-	callExpr, isCallExpr := exprStmt.X.(*ast.CallExpr)
-	if !isCallExpr {
-		return TErrorfCallExpr{}, false
-	}
+	for _, stmt := range blStmts.List {
+		callExpr, method, ok := testingTMethodCallStmt(info, stmt)
+		if !ok {
+			continue
+		}
 
-	selectorExpr, isSelectorExpr := callExpr.Fun.(*ast.SelectorExpr)
-	if !isSelectorExpr {
-		return TErrorfCallExpr{}, false
-	}
+		if kind, isReportMethod := reportMethods[method]; isReportMethod {
+			if reporter, isReporter := newTErrorfCallExpr(callExpr, nil, kind); isReporter {
+				reporters = append(reporters, reporter)
+			}
 
-	ident, isIdent := selectorExpr.X.(*ast.Ident)
-	if !isIdent || ident.Name != testVar || selectorExpr.Sel.Name != "Errorf" {
-		return TErrorfCallExpr{}, false
+			continue
+		}
+
+		if formatted, isLogMethod := logMethods[method]; isLogMethod {
+			logCallExpr, logFormatted = callExpr, formatted
+
+			continue
+		}
+
+		if fatal, isFailMethod := failMethods[method]; isFailMethod && logCallExpr != nil {
+			kind := ReportKind{Fatal: fatal, Formatted: logFormatted}
+			if reporter, isReporter := newTErrorfCallExpr(logCallExpr, callExpr, kind); isReporter {
+				reporters = append(reporters, reporter)
+			}
+
+			logCallExpr = nil
+		}
 	}
 
+	return reporters, len(reporters) > 0
+}
+
+func newTErrorfCallExpr(callExpr, failCallExpr *ast.CallExpr, kind ReportKind) (TErrorfCallExpr, bool) {
 	if len(callExpr.Args) < 2 {
 		return TErrorfCallExpr{}, false
 	}
 
 	basicLit, isBasicLit := callExpr.Args[0].(*ast.BasicLit)
-	if !isBasicLit || basicLit.Kind.String() != "STRING" {
+	isMessage := isBasicLit && basicLit.Kind == token.STRING
+
+	if kind.Formatted && !isMessage {
 		return TErrorfCallExpr{}, false
 	}
 
-	for i := 1; len(callExpr.Args) > i; i++ {
-		_, isParamIdent := callExpr.Args[i].(*ast.Ident)
-
-		_, isTestSelectorExpr := callExpr.Args[i].(*ast.SelectorExpr)
-		if !isParamIdent && !isTestSelectorExpr {
-			return TErrorfCallExpr{}, false
-		}
+	failureMessage := ""
+	if isMessage {
+		failureMessage = basicLit.Value
 	}
 
 	return TErrorfCallExpr{
 		callExpr:       callExpr,
-		failureMessage: basicLit.Value,
+		failCallExpr:   failCallExpr,
+		failureMessage: failureMessage,
+		kind:           kind,
 	}, true
 }
 
+// testingTMethodCallStmt returns the call expression and the method name if the statement is a call to
+// a method of a testing handle, *testing.T, *testing.B, *testing.F or testing.TB.
+func testingTMethodCallStmt(info *types.Info, stmt ast.Stmt) (*ast.CallExpr, string, bool) {
+	exprStmt, isExprStmt := stmt.(*ast.ExprStmt)
+	if !isExprStmt {
+		return nil, "", false
+	}
+
+	callExpr, isCallExpr := exprStmt.X.(*ast.CallExpr)
+	if !isCallExpr {
+		return nil, "", false
+	}
+
+	selectorExpr, isSelectorExpr := callExpr.Fun.(*ast.SelectorExpr)
+	if !isSelectorExpr || !isTestingHandleMethodCall(info, callExpr, selectorExpr.Sel.Name) {
+		return nil, "", false
+	}
+
+	return callExpr, selectorExpr.Sel.Name, true
+}
+
+// CallExpr returns the call that contains the failure message, t.Errorf, t.Error, t.Fatalf, t.Fatal, t.Logf or t.Log.
 func (t TErrorfCallExpr) CallExpr() *ast.CallExpr {
 	return t.callExpr
 }
 
+// FailCallExpr returns the call to t.Fail or t.FailNow that follows t.Logf or t.Log, nil for the other reporters.
+func (t TErrorfCallExpr) FailCallExpr() *ast.CallExpr {
+	return t.failCallExpr
+}
+
+// Kind returns how the failure is reported.
+func (t TErrorfCallExpr) Kind() ReportKind {
+	return t.kind
+}
+
+// FailureMessage returns the failure message string literal as it is written in the source code,
+// it's empty if the reporter is not formatted and its first argument is not a string literal.
 func (t TErrorfCallExpr) FailureMessage() string {
 	return t.failureMessage
 }
 
+// GetArgs returns the values printed in the failure message.
 func (t TErrorfCallExpr) GetArgs() []ast.Expr {
+	if t.failureMessage == "" {
+		return t.callExpr.Args
+	}
+
 	return t.callExpr.Args[1:]
 }
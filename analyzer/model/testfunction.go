@@ -2,45 +2,85 @@ package model
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+)
+
+const (
+	// TestKind is a TestXxx(t *testing.T) function.
+	TestKind TestFunctionKind = "test"
+	// BenchmarkKind is a BenchmarkXxx(b *testing.B) function.
+	BenchmarkKind TestFunctionKind = "benchmark"
+	// FuzzKind is a FuzzXxx(f *testing.F) function.
+	FuzzKind TestFunctionKind = "fuzz"
+	// FuzzCallbackKind is the function passed to f.Fuzz, like in f.Fuzz(func(t *testing.T, in string) {...}).
+	FuzzCallbackKind TestFunctionKind = "fuzz-callback"
+	// HelperKind is a function that receives a *testing.T, *testing.B, *testing.F or testing.TB and it's not
+	// a test entry point.
+	HelperKind TestFunctionKind = "helper"
+)
+
+const (
+	// InlinedTable is a table declared in the range statement.
+	InlinedTable TableSource = "inlined"
+	// LocalTable is a table assigned to a variable in the test function.
+	LocalTable TableSource = "local"
+	// PackageTable is a table assigned to a package-level variable.
+	PackageTable TableSource = "package"
+	// FuncTable is a table returned by a function, like in `for _, tc := range testCases()`.
+	FuncTable TableSource = "func"
 )
 
 type (
-	// TestFunction is the holder of a test function declaration.
-	// A test function must:
-	// 1. Start with "Test".
-	// 2. Have exactly one parameter.
-	// 3. Have that parameter be of type *testing.T.
+	// TestFunctionKind is the kind of function that contains test logic.
+	TestFunctionKind string
+
+	// TableSource is where the table of a table-driven test is declared.
+	TableSource string
+
+	// TestFunction is the holder of a function that contains test logic, identified by its kind:
+	// 1. A TestXxx, BenchmarkXxx or FuzzXxx function with exactly one *testing.T, *testing.B or *testing.F parameter.
+	// 2. The function passed to f.Fuzz.
+	// 3. A helper function that receives a testing handle, *testing.T, *testing.B, *testing.F or testing.TB.
 	TestFunction struct {
-		// importGroup contains the import important on this test.
-		importGroup ImportGroup
+		// info contains the type information of the package where the test is declared.
+		info *types.Info
+
+		// kind of the test function.
+		kind TestFunctionKind
 
-		// testVar is the name given to the testing.T parameter
+		// testVar is the name given to the testing handle parameter
 		testVar string
 
-		// funcDecl the original function declaration.
-		funcDecl *ast.FuncDecl
+		// body of the function, or of the fuzz callback for FuzzCallbackKind.
+		body *ast.BlockStmt
 
 		// tableDrivenInfo table-driven test information for this test function, nil if not a table-driven test.
 		tableDrivenInfo *TableDrivenInfo
 	}
 
-	// ImportGroup contains the imports that are important for the test.
-	ImportGroup struct {
-		// GoCmp "go-cmp" import spec. Nil if "go-cmp" is not imported.
-		GoCmp *ast.ImportSpec
-		// Reflect "reflect" import spec. Nil if "reflect" is not imported.
-		Reflect *ast.ImportSpec
-	}
-
 	// TableDrivenInfo contains information about table-driven test.
 	TableDrivenInfo struct {
-		// Range that iterates over the tests and call t.Run
+		// Range that iterates over the tests and call t.Run, or run the test case in its body.
 		Range *ast.RangeStmt
 		// FormatType is either "map" or "slice".
 		FormatType string
 		// Inlined is true if the table is declared in the range statement.
 		Inlined bool
-		// Block is the body of the t.Run function.
+		// Source is where the table is declared.
+		Source TableSource
+		// Table is the composite literal with the test cases, that can be declared outside the test function for
+		// PackageTable and FuncTable.
+		Table *ast.CompositeLit
+		// Assign is the statement that declares the table in the test function, nil if the table is not a LocalTable.
+		Assign *ast.AssignStmt
+		// Subtests is true if the test cases are run in subtests with t.Run.
+		Subtests bool
+		// Run is the call to t.Run inside the range statement, that can also contain rebindings, like tc := tc,
+		// t.Parallel() calls and logging. It's nil if the test cases are not run in subtests.
+		Run *ast.CallExpr
+		// Block is the body of the t.Run function, or the body of the range statement if there are no subtests.
 		Block *ast.BlockStmt
 	}
 
@@ -58,25 +98,83 @@ type (
 	}
 )
 
-// NewTestFunction returns a new TestFunction based on the funcDecl.
-func NewTestFunction(importGroup ImportGroup, funcDecl *ast.FuncDecl) (TestFunction, bool) {
-	ok, testVar := isTestFunction(funcDecl)
-	if !ok {
+// NewTestFunction returns a new TestFunction based on the funcDecl, if it's a test entry point or a helper.
+// The package declarations are used to resolve the tables declared outside the function.
+func NewTestFunction(info *types.Info, decls PackageDecls, funcDecl *ast.FuncDecl) (TestFunction, bool) {
+	if funcDecl.Body == nil {
 		return TestFunction{}, false
 	}
 
-	tbi := newTableDrivenInfo(testVar, funcDecl)
+	kind, testVar, ok := testFunctionKind(info, funcDecl)
+	if !ok {
+		return TestFunction{}, false
+	}
 
 	return TestFunction{
-		importGroup:     importGroup,
-		testVar:         testVar,
-		funcDecl:        funcDecl,
-		tableDrivenInfo: tbi,
+		info:            info,
+		kind:            kind,
+		testVar:         testVar.Name,
+		body:            funcDecl.Body,
+		tableDrivenInfo: newTableDrivenInfo(info, decls, funcDecl.Body),
 	}, true
 }
 
-func (t TestFunction) ImportGroup() ImportGroup {
-	return t.importGroup
+// NewTestFunctions returns the TestFunction of the funcDecl, and the fuzz callbacks declared in it.
+func NewTestFunctions(info *types.Info, decls PackageDecls, funcDecl *ast.FuncDecl) []TestFunction {
+	testFunc, ok := NewTestFunction(info, decls, funcDecl)
+	if !ok {
+		return nil
+	}
+
+	testFuncs := []TestFunction{testFunc}
+
+	if testFunc.kind != FuzzKind {
+		return testFuncs
+	}
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		callExpr, isCallExpr := n.(*ast.CallExpr)
+		if !isCallExpr || !isTestingHandleMethodCall(info, callExpr, "Fuzz") || len(callExpr.Args) != 1 {
+			return true
+		}
+
+		funcLit, isFuncLit := callExpr.Args[0].(*ast.FuncLit)
+		if !isFuncLit {
+			return true
+		}
+
+		testVar, isTestingHandle := receivesTestingHandle(info, funcLit.Type)
+		if !isTestingHandle {
+			return true
+		}
+
+		testFuncs = append(testFuncs, TestFunction{
+			info:            info,
+			kind:            FuzzCallbackKind,
+			testVar:         testVar.Name,
+			body:            funcLit.Body,
+			tableDrivenInfo: newTableDrivenInfo(info, decls, funcLit.Body),
+		})
+
+		return false
+	})
+
+	return testFuncs
+}
+
+// Kind returns the kind of the test function.
+func (t TestFunction) Kind() TestFunctionKind {
+	return t.kind
+}
+
+// IsKind returns whether the test function is of any of the kinds.
+func (t TestFunction) IsKind(kinds ...TestFunctionKind) bool {
+	return slices.Contains(kinds, t.kind)
+}
+
+// TypesInfo returns the type information of the package where the test is declared.
+func (t TestFunction) TypesInfo() *types.Info {
+	return t.info
 }
 
 // GetActualTestBlockStmt returns the actual block test logic, if it's not a table-driven test
@@ -87,10 +185,15 @@ func (t TestFunction) GetActualTestBlockStmt() *ast.BlockStmt {
 		return t.tableDrivenInfo.Block
 	}
 
-	return t.funcDecl.Body
+	return t.body
 }
 
-// GetTestVar returns the name of the testing.T parameter.
+// GetBody returns the body of the function, or of the fuzz callback for FuzzCallbackKind.
+func (t TestFunction) GetBody() *ast.BlockStmt {
+	return t.body
+}
+
+// GetTestVar returns the name of the testing handle parameter.
 func (t TestFunction) GetTestVar() string {
 	return t.testVar
 }
@@ -99,174 +202,368 @@ func (t TestFunction) GetTableDrivenInfo() *TableDrivenInfo {
 	return t.tableDrivenInfo
 }
 
+// HelperCallBlocks returns the calls to helpers that compare the result of the tested function, the helpers are
+// identified by their HelperFact.
+func (t TestFunction) HelperCallBlocks(factOf HelperFactOf) []HelperCallBlock {
+	toReturn := make([]HelperCallBlock, 0)
+
+	for _, blStmt := range t.ComparisonBlockStmts() {
+		for i, stmt := range blStmt.List {
+			if helperCallBlock, ok := newHelperCallBlock(t.info, factOf, blStmt.List[:i], stmt); ok {
+				toReturn = append(toReturn, helperCallBlock)
+			}
+		}
+	}
+
+	return toReturn
+}
+
 // TestPartBlocks returns all the tested blocks of the test function.
 func (t TestFunction) TestPartBlocks() []TestPartBlock {
-	blStmt := t.GetActualTestBlockStmt()
-	testVar := t.GetTestVar()
-
 	toReturn := make([]TestPartBlock, 0)
 
-	var stmts []ast.Stmt
-	if blStmt != nil {
-		stmts = blStmt.List
-	}
+	for _, blStmt := range t.ComparisonBlockStmts() {
+		stmts := blStmt.List
 
-	for i, stmt := range stmts {
-		if ifStmt, ok := stmt.(*ast.IfStmt); ok {
-			if i == 0 {
+		for i, stmt := range stmts {
+			ifStmt, ok := stmt.(*ast.IfStmt)
+			if !ok {
 				continue
 			}
 
-			// the statement should contain the tested function, unless the previous assignment is another if stmt
-			// that may contain another testing condition.
-			prev := stmts[i-1]
-			if _, prevIsIfStmt := prev.(*ast.IfStmt); prevIsIfStmt && i-2 > -1 {
-				prev = stmts[i-2]
-			}
+			// the tested function is the call that produced the compared variables, however far back it is.
+			gotIdents, otherIdents := comparedOperands(t.info, ifStmt)
+			testedStmt := testedCallStmt(t.info, stmts[:i], gotIdents, otherIdents)
 
-			testBlock, isTestBlock := NewTestPartBlock(t.ImportGroup(), testVar, prev, ifStmt)
+			testBlocks, isTestBlock := NewTestPartBlocks(t.info, testedStmt, ifStmt)
 			if !isTestBlock {
 				continue
 			}
 
-			toReturn = append(toReturn, testBlock)
+			toReturn = append(toReturn, testBlocks...)
 		}
 	}
 
 	return toReturn
 }
 
-func (i ImportGroup) ReflectImportName() (string, bool) {
-	if i.Reflect == nil {
-		return "", false
+// ComparisonBlockStmts returns the blocks whose statements contain the comparisons, the actual test block, and the
+// body of the function if the table-driven test does not use subtests, so the comparisons outside the loop are
+// found too.
+func (t TestFunction) ComparisonBlockStmts() []*ast.BlockStmt {
+	blStmt := t.GetActualTestBlockStmt()
+	if blStmt == nil {
+		return nil
+	}
+
+	if t.tableDrivenInfo != nil && !t.tableDrivenInfo.Subtests && t.body != nil {
+		return []*ast.BlockStmt{t.body, blStmt}
 	}
 
-	return importName(i.Reflect), true
+	return []*ast.BlockStmt{blStmt}
 }
 
-func (i ImportGroup) GoCmpImportName() (string, bool) {
-	if i.GoCmp == nil {
-		return "", false
+// ComparisonBlockStmtOf returns the block, among the ComparisonBlockStmts, whose statements contain the statement,
+// or nil if none of them contains it.
+func (t TestFunction) ComparisonBlockStmtOf(stmt ast.Stmt) *ast.BlockStmt {
+	for _, blStmt := range t.ComparisonBlockStmts() {
+		if slices.Contains(blStmt.List, stmt) {
+			return blStmt
+		}
 	}
 
-	return importName(i.GoCmp), true
+	return nil
 }
 
 // newTableDrivenInfo returns information about a table driven test or nil if it's not a table-driven test.
-//
-//nolint:gocognit,funlen // refactor later
-func newTableDrivenInfo(testVar string, funcDecl *ast.FuncDecl) *TableDrivenInfo {
+func newTableDrivenInfo(info *types.Info, decls PackageDecls, body *ast.BlockStmt) *TableDrivenInfo {
 	var stmts []ast.Stmt
-	if funcDecl.Body != nil {
-		stmts = funcDecl.Body.List
+	if body != nil {
+		stmts = body.List
 	}
 
-	identifiers := make(map[string]*ast.CompositeLit)
-
-	var rangeStmt *ast.RangeStmt
+	identifiers := make(map[types.Object]*ast.AssignStmt)
 
 	for _, stmt := range stmts {
 		switch node := stmt.(type) {
-		// possible identifiers that can be used in a table-driven test
+		// possible identifiers that can be used in a table-driven test, the tables or the calls that return them
 		case *ast.AssignStmt:
-			if len(node.Rhs) != 1 {
-				continue
-			}
-
-			mapOrSliceCompositeLit := isMapOrSliceCompositeLit(node.Rhs[0])
-			if mapOrSliceCompositeLit == nil {
+			if len(node.Rhs) != 1 || len(node.Lhs) != 1 {
 				continue
 			}
 
-			if len(node.Lhs) != 1 {
+			switch node.Rhs[0].(type) {
+			case *ast.CompositeLit, *ast.CallExpr:
+			default:
 				continue
 			}
 
 			if ident, ok := node.Lhs[0].(*ast.Ident); ok {
-				identifiers[ident.Name] = mapOrSliceCompositeLit
+				if obj := info.ObjectOf(ident); obj != nil {
+					identifiers[obj] = node
+				}
 			}
 		// possible for loops that can be used in a table-driven test
 		case *ast.RangeStmt:
-			// the next instruction in a range stmt needs to be a t.Run
-			if node.Body != nil && len(node.Body.List) != 1 {
+			// the range body needs to run the test case in a t.Run, or to report the failures of the test case itself
+			callExpr, funcLit, subtests := rangeRun(info, node.Body)
+
+			block := node.Body
+			if subtests {
+				block = funcLit.Body
+			} else if !reportsFailure(info, node.Body) {
 				continue
 			}
 
-			exprStmt, isExprStmt := node.Body.List[0].(*ast.ExprStmt)
-			if !isExprStmt {
+			// from here, it's a table-driven test, we need to check whether is map/slice and where it's declared
+			table, ok := resolveTable(info, decls, identifiers, node.X)
+			if !ok {
 				continue
 			}
 
-			callExpr, isCallExpr := exprStmt.X.(*ast.CallExpr)
-			if !isCallExpr {
+			// without subtests, only the loops over test cases are table-driven tests, not any loop over values.
+			if !subtests && !isTestCasesTable(info, table.compositeLit) {
 				continue
 			}
 
-			selectorExpr, isSelectorExpr := callExpr.Fun.(*ast.SelectorExpr)
-			if !isSelectorExpr {
-				continue
+			formatType := "map"
+			if _, isSlice := table.compositeLit.Type.(*ast.ArrayType); isSlice {
+				formatType = "slice"
 			}
 
-			//nolint:lll // long line
-			if ident, isIdent := selectorExpr.X.(*ast.Ident); !isIdent || ident.Name != testVar || selectorExpr.Sel.Name != "Run" {
-				continue
+			return &TableDrivenInfo{
+				Range:      node,
+				FormatType: formatType,
+				Inlined:    table.source == InlinedTable,
+				Source:     table.source,
+				Table:      table.compositeLit,
+				Assign:     table.assign,
+				Subtests:   subtests,
+				Run:        callExpr,
+				Block:      block,
 			}
+		}
+	}
 
-			funcLit, isFuncLit := callExpr.Args[1].(*ast.FuncLit)
-			if !isFuncLit {
-				continue
+	return nil
+}
+
+// isTestCasesTable returns whether the table contains test cases, this is, it's a map or a slice of structs.
+func isTestCasesTable(info *types.Info, compositeLit *ast.CompositeLit) bool {
+	switch t := types.Unalias(info.TypeOf(compositeLit)).Underlying().(type) {
+	case *types.Map:
+		return true
+	case *types.Slice:
+		elem := t.Elem()
+		if ptr, isPtr := elem.Underlying().(*types.Pointer); isPtr {
+			elem = ptr.Elem()
+		}
+
+		_, isStruct := elem.Underlying().(*types.Struct)
+
+		return isStruct
+	default:
+		return false
+	}
+}
+
+// tableDecl is the declaration of the table of test cases of a table-driven test.
+type tableDecl struct {
+	compositeLit *ast.CompositeLit
+	source       TableSource
+	// assign is the statement that declares the table in the test function, for LocalTable.
+	assign *ast.AssignStmt
+}
+
+// resolveTable returns the declaration of the table the range statement iterates over, either inlined in the range
+// statement, a local variable, a package-level variable or returned by a function.
+func resolveTable(
+	info *types.Info,
+	decls PackageDecls,
+	identifiers map[types.Object]*ast.AssignStmt,
+	expr ast.Expr,
+) (tableDecl, bool) {
+	switch n := expr.(type) {
+	case *ast.CompositeLit:
+		compositeLit := isMapOrSliceCompositeLit(n)
+
+		return tableDecl{compositeLit: compositeLit, source: InlinedTable}, compositeLit != nil
+	case *ast.CallExpr:
+		compositeLit, ok := decls.returnedTable(info, n)
+
+		return tableDecl{compositeLit: compositeLit, source: FuncTable}, ok
+	case *ast.Ident:
+		obj := info.Uses[n]
+		if obj == nil {
+			return tableDecl{}, false
+		}
+
+		if assignStmt, isLocal := identifiers[obj]; isLocal {
+			if compositeLit := isMapOrSliceCompositeLit(assignStmt.Rhs[0]); compositeLit != nil {
+				return tableDecl{compositeLit: compositeLit, source: LocalTable, assign: assignStmt}, true
 			}
-			// from here, it's a table-driven test, we need to check whether is map/slice or inlined
-			rangeStmt = node
-
-			switch n := node.X.(type) {
-			case *ast.Ident:
-				// identifier must be declared before and be used as range
-				if _, isDeclaredBefore := identifiers[n.Name]; !isDeclaredBefore {
-					continue
-				}
 
-				// returns the second parameter of t.Run with the function
-				if len(callExpr.Args) != 2 {
-					continue
-				}
+			callExpr, isCallExpr := assignStmt.Rhs[0].(*ast.CallExpr)
+			if !isCallExpr {
+				return tableDecl{}, false
+			}
 
-				// is non-inlined and the param contains whether is map/slice
-				formatType := "map"
-				if _, isSlice := identifiers[n.Name].Type.(*ast.ArrayType); isSlice {
-					formatType = "slice"
-				}
+			compositeLit, ok := decls.returnedTable(info, callExpr)
 
-				return &TableDrivenInfo{
-					Range:      rangeStmt,
-					FormatType: formatType,
-					Inlined:    false,
-					Block:      funcLit.Body,
-				}
-			case *ast.CompositeLit:
-				// is inlined
-				if isMapOrSliceCompositeLit(n) == nil {
-					continue
-				}
+			return tableDecl{compositeLit: compositeLit, source: FuncTable}, ok
+		}
+
+		value, isPackageVar := decls.vars[obj]
+		if !isPackageVar {
+			return tableDecl{}, false
+		}
+
+		if callExpr, isCallExpr := value.(*ast.CallExpr); isCallExpr {
+			compositeLit, ok := decls.returnedTable(info, callExpr)
+
+			return tableDecl{compositeLit: compositeLit, source: FuncTable}, ok
+		}
+
+		compositeLit := isMapOrSliceCompositeLit(value)
+
+		return tableDecl{compositeLit: compositeLit, source: PackageTable}, compositeLit != nil
+	}
+
+	return tableDecl{}, false
+}
+
+// IsShared returns whether the table is declared outside the test function, in a package-level variable or returned
+// by a function, so other tests can use it too.
+func (t *TableDrivenInfo) IsShared() bool {
+	return t.Source == PackageTable || t.Source == FuncTable
+}
+
+// RangeVarObject returns the object of the range variable the identifier refers to, following the rebindings of
+// the range body, like tc := tc. It returns the object of the identifier if it's not a rebinding.
+func (t *TableDrivenInfo) RangeVarObject(info *types.Info, ident *ast.Ident) types.Object {
+	obj := info.ObjectOf(ident)
+
+	rebindings := make(map[types.Object]types.Object)
+
+	for _, stmt := range t.Range.Body.List {
+		assignStmt, isAssignStmt := stmt.(*ast.AssignStmt)
+		if !isAssignStmt || !isRebinding(assignStmt) {
+			continue
+		}
+
+		for i, lhs := range assignStmt.Lhs {
+			lhsIdent, isLhsIdent := lhs.(*ast.Ident)
+			rhsIdent, isRhsIdent := assignStmt.Rhs[i].(*ast.Ident)
+
+			if isLhsIdent && isRhsIdent {
+				rebindings[info.ObjectOf(lhsIdent)] = info.ObjectOf(rhsIdent)
+			}
+		}
+	}
+
+	for rebound, ok := rebindings[obj]; ok && rebound != nil; rebound, ok = rebindings[obj] {
+		obj = rebound
+	}
+
+	return obj
+}
+
+// rangeRun returns the call to t.Run of the range body and the function run. Besides the t.Run, the body can only
+// contain rebindings of variables, like tc := tc, t.Parallel() calls and logging, like t.Logf(...).
+func rangeRun(info *types.Info, body *ast.BlockStmt) (*ast.CallExpr, *ast.FuncLit, bool) {
+	if body == nil {
+		return nil, nil, false
+	}
+
+	var (
+		run     *ast.CallExpr
+		funcLit *ast.FuncLit
+	)
+
+	for _, stmt := range body.List {
+		switch node := stmt.(type) {
+		case *ast.AssignStmt:
+			if !isRebinding(node) {
+				return nil, nil, false
+			}
+		case *ast.ExprStmt:
+			callExpr, isCallExpr := node.X.(*ast.CallExpr)
+			if !isCallExpr {
+				return nil, nil, false
+			}
 
-				formatType := "map"
-				if _, isSlice := n.Type.(*ast.ArrayType); isSlice {
-					formatType = "slice"
+			switch {
+			case isTestingHandleMethodCall(info, callExpr, "Run"):
+				if run != nil || len(callExpr.Args) != 2 {
+					return nil, nil, false
 				}
 
-				return &TableDrivenInfo{
-					Range:      rangeStmt,
-					FormatType: formatType,
-					Inlined:    true,
-					Block:      funcLit.Body,
+				fl, isFuncLit := callExpr.Args[1].(*ast.FuncLit)
+				if !isFuncLit {
+					return nil, nil, false
 				}
-			default:
+
+				run, funcLit = callExpr, fl
+			case isTestingHandleMethodCall(info, callExpr, "Parallel"),
+				isTestingHandleMethodCall(info, callExpr, "Log"),
+				isTestingHandleMethodCall(info, callExpr, "Logf"):
 				continue
+			default:
+				return nil, nil, false
 			}
+		default:
+			return nil, nil, false
 		}
 	}
 
-	return nil
+	return run, funcLit, run != nil
+}
+
+// reportsFailure returns whether the block reports a failure through a testing handle, like t.Errorf or t.FailNow,
+// outside the function literals it declares.
+func reportsFailure(info *types.Info, body *ast.BlockStmt) bool {
+	found := false
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			selectorExpr, isSelectorExpr := node.Fun.(*ast.SelectorExpr)
+			if !isSelectorExpr {
+				return !found
+			}
+
+			_, isReportMethod := reportMethods[selectorExpr.Sel.Name]
+			_, isFailMethod := failMethods[selectorExpr.Sel.Name]
+
+			if (isReportMethod || isFailMethod) && isTestingHandle(info.TypeOf(selectorExpr.X)) {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isRebinding returns whether the statement declares variables with the value of other variables, like tc := tc.
+func isRebinding(assignStmt *ast.AssignStmt) bool {
+	if assignStmt.Tok != token.DEFINE || len(assignStmt.Lhs) != len(assignStmt.Rhs) {
+		return false
+	}
+
+	for i, lhs := range assignStmt.Lhs {
+		_, isLhsIdent := lhs.(*ast.Ident)
+		_, isRhsIdent := assignStmt.Rhs[i].(*ast.Ident)
+
+		if !isLhsIdent || !isRhsIdent {
+			return false
+		}
+	}
+
+	return true
 }
 
 // NewTestedCallExpr creates a testedFuncStmt after checking that the stmt is a typical function call.
@@ -3,6 +3,8 @@ package model
 import (
 	"go/ast"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestFunctionName(t *testing.T) {
@@ -65,3 +67,140 @@ func TestFunctionName(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTestFunctionsKinds(t *testing.T) {
+	t.Parallel()
+
+	content := `package main
+
+import "testing"
+
+func double(a int) int { return 2 * a }
+
+func TestDouble(t *testing.T) {}
+
+func BenchmarkDouble(b *testing.B) {}
+
+func FuzzDouble(f *testing.F) {
+	f.Fuzz(func(t *testing.T, in int) {})
+}
+
+func checkDouble(tb testing.TB, in, want int) {}
+
+func notATest(in int) {}
+`
+
+	node, info := typeCheck(t, content)
+
+	got := make([]TestFunctionKind, 0)
+
+	for _, decl := range node.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		for _, testFunc := range NewTestFunctions(info, NewPackageDecls(info, []*ast.File{node}), funcDecl) {
+			got = append(got, testFunc.Kind())
+		}
+	}
+
+	want := []TestFunctionKind{TestKind, BenchmarkKind, FuzzKind, FuzzCallbackKind, HelperKind}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewTestFunctions() kinds mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewTableDrivenInfoSource(t *testing.T) {
+	t.Parallel()
+
+	content := `package main
+
+import "testing"
+
+type testCase struct {
+	name string
+	in   string
+	want int
+}
+
+var packageTests = []testCase{{name: "example", in: "1", want: 1}}
+
+var packageFuncTests = testCases()
+
+func testCases() []testCase {
+	tests := []testCase{{name: "example", in: "1", want: 1}}
+
+	return tests
+}
+
+func TestInlined(t *testing.T) {
+	for _, tc := range []testCase{{name: "example", in: "1", want: 1}} {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+
+func TestLocal(t *testing.T) {
+	tests := []testCase{{name: "example", in: "1", want: 1}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+
+func TestPackage(t *testing.T) {
+	for _, tc := range packageTests {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+
+func TestPackageFunc(t *testing.T) {
+	for _, tc := range packageFuncTests {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+
+func TestFunc(t *testing.T) {
+	for _, tc := range testCases() {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+
+func TestLocalFunc(t *testing.T) {
+	tests := testCases()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+`
+
+	node, info := typeCheck(t, content)
+	decls := NewPackageDecls(info, []*ast.File{node})
+
+	got := make(map[string]TableSource)
+
+	for _, decl := range node.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		testFunc, ok := NewTestFunction(info, decls, funcDecl)
+		if !ok || testFunc.GetTableDrivenInfo() == nil {
+			continue
+		}
+
+		got[funcDecl.Name.Name] = testFunc.GetTableDrivenInfo().Source
+	}
+
+	want := map[string]TableSource{
+		"TestInlined":     InlinedTable,
+		"TestLocal":       LocalTable,
+		"TestPackage":     PackageTable,
+		"TestPackageFunc": FuncTable,
+		"TestFunc":        FuncTable,
+		"TestLocalFunc":   FuncTable,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("TableDrivenInfo.Source mismatch (-want +got):\n%s", diff)
+	}
+}
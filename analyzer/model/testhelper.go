@@ -0,0 +1,170 @@
+package model
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// TestHelper is a function or a closure that receives a testing handle, *testing.T, *testing.B, *testing.F or
+// testing.TB, and that is not a test itself, like:
+//
+//	func assertAbs(t *testing.T, in, want int) {
+//		t.Helper()
+//
+//		if got := Abs(in); got != want {
+//			t.Errorf("Abs(%v) = %v, want %v", in, got, want)
+//		}
+//	}
+type TestHelper struct {
+	info *types.Info
+
+	// funcType is the signature of the function or the closure.
+	funcType *ast.FuncType
+
+	// body is the body of the function or the closure.
+	body *ast.BlockStmt
+
+	// testVar is the testing handle parameter.
+	testVar *ast.Ident
+}
+
+// NewTestHelpers returns the test helpers found in the function declaration, the function itself if it's not a test
+// entry point, and the closures declared inside it.
+// The closures passed to t.Run, b.Run or f.Fuzz are subtests, so they are not test helpers.
+func NewTestHelpers(info *types.Info, funcDecl *ast.FuncDecl) []TestHelper {
+	testHelpers := make([]TestHelper, 0)
+
+	if funcDecl.Body == nil {
+		return testHelpers
+	}
+
+	if testHelper, ok := newTestHelper(info, funcDecl.Type, funcDecl.Body); ok && !isTestEntryPoint(info, funcDecl) {
+		testHelpers = append(testHelpers, testHelper)
+	}
+
+	subtests := make(map[*ast.FuncLit]bool)
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			// the call is visited before its arguments
+			if isTestingHandleMethodCall(info, node, "Run") || isTestingHandleMethodCall(info, node, "Fuzz") {
+				for _, arg := range node.Args {
+					if funcLit, isFuncLit := arg.(*ast.FuncLit); isFuncLit {
+						subtests[funcLit] = true
+					}
+				}
+			}
+		case *ast.FuncLit:
+			if subtests[node] {
+				return true
+			}
+
+			if testHelper, ok := newTestHelper(info, node.Type, node.Body); ok {
+				testHelpers = append(testHelpers, testHelper)
+			}
+		}
+
+		return true
+	})
+
+	return testHelpers
+}
+
+func newTestHelper(info *types.Info, funcType *ast.FuncType, body *ast.BlockStmt) (TestHelper, bool) {
+	testVar, ok := receivesTestingHandle(info, funcType)
+	if !ok {
+		return TestHelper{}, false
+	}
+
+	return TestHelper{
+		info:     info,
+		funcType: funcType,
+		body:     body,
+		testVar:  testVar,
+	}, true
+}
+
+// FuncType returns the signature of the test helper.
+func (t TestHelper) FuncType() *ast.FuncType {
+	return t.funcType
+}
+
+// Body returns the body of the test helper.
+func (t TestHelper) Body() *ast.BlockStmt {
+	return t.body
+}
+
+// GetTestVar returns the testing handle parameter.
+func (t TestHelper) GetTestVar() *ast.Ident {
+	return t.testVar
+}
+
+// CallsHelper returns whether the first statement of the test helper is a call to t.Helper().
+func (t TestHelper) CallsHelper() bool {
+	if len(t.body.List) == 0 {
+		return false
+	}
+
+	callExpr, method, ok := t.testVarMethodCallStmt(t.body.List[0])
+
+	return ok && method == "Helper" && len(callExpr.Args) == 0
+}
+
+// ReportsFailures returns whether the test helper calls any of the Error or Fatal methods of the testing handle.
+func (t TestHelper) ReportsFailures() bool {
+	return t.reportsFailures(t.body)
+}
+
+// reportsFailures returns whether the node contains a call to any of the Error or Fatal methods of the testing handle.
+func (t TestHelper) reportsFailures(node ast.Node) bool {
+	found := false
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		callExpr, isCallExpr := n.(*ast.CallExpr)
+		if !isCallExpr {
+			return !found
+		}
+
+		if method, ok := t.testVarMethod(callExpr); ok &&
+			(strings.HasPrefix(method, "Error") || strings.HasPrefix(method, "Fatal")) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+func (t TestHelper) testVarMethodCallStmt(stmt ast.Stmt) (*ast.CallExpr, string, bool) {
+	exprStmt, isExprStmt := stmt.(*ast.ExprStmt)
+	if !isExprStmt {
+		return nil, "", false
+	}
+
+	callExpr, isCallExpr := exprStmt.X.(*ast.CallExpr)
+	if !isCallExpr {
+		return nil, "", false
+	}
+
+	method, ok := t.testVarMethod(callExpr)
+
+	return callExpr, method, ok
+}
+
+// testVarMethod returns the method name if the call expression is a method call on the testing handle parameter.
+func (t TestHelper) testVarMethod(callExpr *ast.CallExpr) (string, bool) {
+	selectorExpr, isSelectorExpr := callExpr.Fun.(*ast.SelectorExpr)
+	if !isSelectorExpr {
+		return "", false
+	}
+
+	ident, isIdent := selectorExpr.X.(*ast.Ident)
+	if !isIdent || !isSameObject(t.info, ident, t.testVar) {
+		return "", false
+	}
+
+	return selectorExpr.Sel.Name, true
+}
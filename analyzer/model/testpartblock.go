@@ -2,6 +2,7 @@ package model
 
 import (
 	"go/ast"
+	"go/types"
 )
 
 // TestPartBlock is a struct that holds the typical testing block like:
@@ -10,9 +11,15 @@ import (
 //		if got != want {
 //		  t.Errorf(...)
 //		}
+//
+// or with the tested function called in the if init:
+//
+//		if got := MyFunction(in); got != want {
+//		  t.Errorf(...)
+//		}
+//
+// If the if body reports the failure more than once, there is a TestPartBlock for each call.
 type TestPartBlock struct {
-	importGroup ImportGroup
-
 	// testedFunc contain the actual call to the function tested.
 	testedFunc TestedCallExpr
 
@@ -23,33 +30,43 @@ type TestPartBlock struct {
 	tErrorCallExpr TErrorfCallExpr
 }
 
-func NewTestPartBlock(
-	importGroup ImportGroup,
-	testVar string,
-	prev ast.Stmt,
+// NewTestPartBlocks creates a TestPartBlock for each call that reports a failure in the if body, the tested function
+// is taken from the if init if present, or from testedStmt, the statement that produced the compared variables,
+// otherwise.
+func NewTestPartBlocks(
+	info *types.Info,
+	testedStmt ast.Stmt,
 	ifStmt *ast.IfStmt,
-) (TestPartBlock, bool) {
-	testedFunc, isTestedFunc := NewTestedCallExpr(prev)
+) ([]TestPartBlock, bool) {
+	if ifStmt.Init != nil && !isDiffParamIfStmt(info, ifStmt) {
+		testedStmt = ifStmt.Init
+	}
+
+	testedFunc, isTestedFunc := NewTestedCallExpr(testedStmt)
 	if !isTestedFunc {
-		return TestPartBlock{}, false
+		return nil, false
 	}
 
-	ifComparing, isComparingIfStmt := NewIfComparingResult(importGroup, testedFunc.Params(), ifStmt)
+	ifComparing, isComparingIfStmt := NewIfComparingResult(info, testedFunc.Params(), ifStmt)
 	if !isComparingIfStmt {
-		return TestPartBlock{}, false
+		return nil, false
 	}
 
-	teCallExpr, istErrorf := NewTErrorfCallExpr(testVar, ifStmt.Body)
+	teCallExprs, istErrorf := NewTErrorfCallExprs(info, ifStmt.Body)
 	if !istErrorf {
-		return TestPartBlock{}, false
+		return nil, false
+	}
+
+	testBlocks := make([]TestPartBlock, 0, len(teCallExprs))
+	for _, teCallExpr := range teCallExprs {
+		testBlocks = append(testBlocks, TestPartBlock{
+			testedFunc:     testedFunc,
+			ifComparing:    ifComparing,
+			tErrorCallExpr: teCallExpr,
+		})
 	}
 
-	return TestPartBlock{
-		importGroup:    importGroup,
-		testedFunc:     testedFunc,
-		ifComparing:    ifComparing,
-		tErrorCallExpr: teCallExpr,
-	}, true
+	return testBlocks, true
 }
 
 func (t TestPartBlock) TestedFunc() TestedCallExpr {
@@ -0,0 +1,29 @@
+package main
+
+func double(a int) int {
+	return 2 * a
+}
+
+func sum(a, b int) int {
+	return a + b
+}
+
+func parse(s string) (int, error) {
+	return len(s), nil
+}
+
+type point struct {
+	x, y int
+}
+
+func newPoint(x, y int) point {
+	return point{x: x, y: y}
+}
+
+var counter int
+
+func next() int {
+	counter++
+
+	return counter
+}
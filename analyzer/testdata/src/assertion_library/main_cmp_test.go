@@ -0,0 +1,21 @@
+package main
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumCmpImported(t *testing.T) {
+	t.Parallel()
+
+	want := 3
+	got := sum(1, 2)
+	if !gocmp.Equal(got, want) {
+		t.Errorf("sum(%v, %v) = %v, want %v", 1, 2, got, want)
+	}
+
+	got = sum(2, 2)
+	assert.Equal(t, 4, got) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoubleAssertEqual(t *testing.T) {
+	t.Parallel()
+
+	want := 4
+	got := double(2)
+	assert.Equal(t, want, got) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, compare the values with cmp and report the failure with t.Errorf`
+}
+
+func TestSumRequireEqual(t *testing.T) {
+	t.Parallel()
+
+	got := sum(1, 2)
+	require.Equal(t, 3, got) // want `Assertion library "github.com/stretchr/testify/require" should not be used, .*`
+}
+
+func TestNoTestedFunction(t *testing.T) {
+	t.Parallel()
+
+	got := 4
+	assert.Equal(t, 4, got) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
+
+func TestParseNoError(t *testing.T) {
+	t.Parallel()
+
+	got, err := parse("a")
+	require.NoError(t, err) // want `Assertion library "github.com/stretchr/testify/require" should not be used, .*`
+	assert.Equal(t, 1, got, "parse") // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
+
+func TestDoubleAssertions(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+	got := double(2)
+	a.Equal(4, got) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
+
+func TestDoubleGomega(t *testing.T) {
+	t.Parallel()
+
+	got := double(2)
+	gomega.Expect(got).To(gomega.Equal(4)) // want `Assertion library "github.com/onsi/gomega" should not be used, .*`
+}
+
+func TestDoubleInIf(t *testing.T) {
+	t.Parallel()
+
+	got := double(2)
+	if !assert.Equal(t, 4, got) { // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+		return
+	}
+}
+
+func TestDoubleTableDriven(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"two": {
+			in:   2,
+			want: 4,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			assert.Equal(t, test.want, got) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+		})
+	}
+}
+
+func TestNewPointUnexportedFields(t *testing.T) {
+	t.Parallel()
+
+	got := newPoint(1, 2)
+	assert.Equal(t, point{x: 1, y: 2}, got) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
+
+type collectT struct {
+	errors []string
+}
+
+func (c *collectT) Errorf(format string, args ...any) {
+	c.errors = append(c.errors, format)
+}
+
+func (c *collectT) FailNow() {}
+
+func TestDoubleNotTestingHandle(t *testing.T) {
+	t.Parallel()
+
+	c := &collectT{}
+	got := double(2)
+	require.Equal(c, 4, got) // want `Assertion library "github.com/stretchr/testify/require" should not be used, .*`
+}
+
+func TestNextCallInAssertion(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{1}, []int{next()}) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
+
+func TestNextGotDeclared(t *testing.T) {
+	t.Parallel()
+
+	got := 0
+	assert.Equal(t, got+1, next()) // want `Assertion library "github.com/stretchr/testify/assert" should not be used, .*`
+}
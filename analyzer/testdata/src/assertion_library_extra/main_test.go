@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/acme/should"
+	"github.com/stretchr/testify/assert"
+)
+
+func double(a int) int {
+	return 2 * a
+}
+
+func TestDoubleShould(t *testing.T) {
+	t.Parallel()
+
+	got := double(2)
+	if !should.BeEqual(got, 4) { // want `Assertion library "github.com/acme/should" should not be used, .*`
+		t.Errorf("double(%v) = %v, want %v", 2, got, 4)
+	}
+}
+
+func TestDoubleDefaultLibraryReplaced(t *testing.T) {
+	t.Parallel()
+
+	got := double(2)
+	assert.Equal(t, 4, got)
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+type user struct {
+	name, surname string
+	age           int
+}
+
+type pet struct {
+	name string
+}
+
+func newUser(name string, age int) user {
+	return user{name: name, age: age}
+}
+
+func newUserPtr(name string) *user {
+	return &user{name: name}
+}
+
+func newPet(name string) pet {
+	return pet{name: name}
+}
+
+func TestNewUserFields(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John", age: 30}
+	got := newUser("John", 30)
+	if got.name != want.name { // want `Compare the full structures instead of field by field, like in .*`
+		t.Errorf("newUser(%q, %v).name = %v, want %v", "John", 30, got.name, want.name)
+	}
+	if got.age != want.age {
+		t.Errorf("newUser(%q, %v).age = %v, want %v", "John", 30, got.age, want.age)
+	}
+}
+
+func TestNewUserPtrFieldsWantFirst(t *testing.T) {
+	t.Parallel()
+
+	want := &user{name: "John"}
+	got := newUserPtr("John")
+	if want.name != got.name { // want `Compare the full structures instead of field by field, like in .*`
+		t.Fatalf("newUserPtr(%q).name = %v, want %v", "John", got.name, want.name)
+	}
+	if want.surname != got.surname {
+		t.Fatalf("newUserPtr(%q).surname = %v, want %v", "John", got.surname, want.surname)
+	}
+}
+
+func TestNewUserTableDriven(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   string
+		want user
+	}{
+		"john": {
+			in:   "John",
+			want: user{name: "John"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := newUser(test.in, 0)
+			if got.name != test.want.name { // want `Compare the full structures instead of field by field, like in .*`
+				t.Errorf("newUser(%q, %v).name = %v, want %v", test.in, 0, got.name, test.want.name)
+			}
+			if got.surname != test.want.surname {
+				t.Errorf("newUser(%q, %v).surname = %v, want %v", test.in, 0, got.surname, test.want.surname)
+			}
+			if got.age != test.want.age {
+				t.Errorf("newUser(%q, %v).age = %v, want %v", test.in, 0, got.age, test.want.age)
+			}
+		})
+	}
+}
+
+func TestNewUserSingleField(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John", 0)
+	if got.name != want.name {
+		t.Errorf("newUser(%q, %v).name = %v, want %v", "John", 0, got.name, want.name)
+	}
+
+	other := newUser("Jane", 0)
+	if other.name != want.name {
+		t.Errorf("newUser(%q, %v).name = %v, want %v", "Jane", 0, other.name, want.name)
+	}
+}
+
+func TestDifferentStructTypes(t *testing.T) {
+	t.Parallel()
+
+	u := newUser("John", 0)
+	p := newPet("John")
+	if u.name != p.name {
+		t.Errorf("newUser(%q, %v).name = %v, want %v", "John", 0, u.name, p.name)
+	}
+	if u.name != p.name {
+		t.Errorf("newUser(%q, %v).name = %v, want %v", "John", 0, u.name, p.name)
+	}
+}
+
+type Account struct {
+	Name string
+	Age  int
+}
+
+type Profile struct {
+	Name    string
+	Age     int
+	Created time.Time
+}
+
+func newAccount(name string, age int) Account {
+	return Account{Name: name, Age: age}
+}
+
+func newProfile(name string, age int) Profile {
+	return Profile{Name: name, Age: age, Created: time.Now()}
+}
+
+func TestNewAccountAllFields(t *testing.T) {
+	t.Parallel()
+
+	want := Account{Name: "John", Age: 30}
+	got := newAccount("John", 30)
+	if got.Name != want.Name { // want `Compare the full structures instead of field by field, like in .*`
+		t.Errorf("newAccount(%q, %v).Name = %v, want %v", "John", 30, got.Name, want.Name)
+	}
+	if got.Age != want.Age {
+		t.Errorf("newAccount(%q, %v).Age = %v, want %v", "John", 30, got.Age, want.Age)
+	}
+}
+
+func TestNewProfileSomeFields(t *testing.T) {
+	t.Parallel()
+
+	want := Profile{Name: "John", Age: 30}
+	got := newProfile("John", 30)
+	if got.Name != want.Name { // want `Compare the full structures instead of field by field, like in .*`
+		t.Errorf("newProfile(%q, %v).Name = %v, want %v", "John", 30, got.Name, want.Name)
+	}
+	if got.Age != want.Age {
+		t.Errorf("newProfile(%q, %v).Age = %v, want %v", "John", 30, got.Age, want.Age)
+	}
+}
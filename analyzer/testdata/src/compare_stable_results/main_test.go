@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func describe(u user) string {
+	return "user " + u.Name
+}
+
+func TestUserJSON(t *testing.T) {
+	t.Parallel()
+
+	want := `{"name":"John"}`
+	got, err := json.Marshal(user{Name: "John"})
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) err = %v, want nil", user{Name: "John"}, err)
+	}
+	if diff := cmp.Diff(want, string(got)); diff != "" { // want `The output of json.Marshal is not stable, parse it and compare the values semantically, .*`
+		t.Errorf("json.Marshal(%v) mismatch (-want +got):\n%s", user{Name: "John"}, diff)
+	}
+}
+
+func TestUserJSONStringConversion(t *testing.T) {
+	t.Parallel()
+
+	want := `{"name":"John"}`
+	b, err := json.MarshalIndent(user{Name: "John"}, "", "")
+	if err != nil {
+		t.Fatalf("json.MarshalIndent(%v) err = %v, want nil", user{Name: "John"}, err)
+	}
+	got := string(b)
+	if got != want { // want `The output of json.MarshalIndent is not stable, .*`
+		t.Errorf("json.MarshalIndent(%v) = %v, want %v", user{Name: "John"}, got, want)
+	}
+}
+
+func TestUserSprint(t *testing.T) {
+	t.Parallel()
+
+	want := "{John}"
+	if got := fmt.Sprint(user{Name: "John"}); got != want { // want `The output of fmt.Sprint is not stable, .*`
+		t.Errorf("fmt.Sprint(%v) = %v, want %v", user{Name: "John"}, got, want)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	want := "user John"
+	got := describe(user{Name: "John"})
+	if got != want {
+		t.Errorf("describe(%v) = %v, want %v", user{Name: "John"}, got, want)
+	}
+}
+
+func TestUserJSONUnmarshalled(t *testing.T) {
+	t.Parallel()
+
+	want := user{Name: "John"}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) err = %v, want nil", want, err)
+	}
+	var got user
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%v) err = %v, want nil", b, err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("json.Unmarshal(%v) mismatch (-want +got):\n%s", b, diff)
+	}
+}
+
+func TestSprintBasicValues(t *testing.T) {
+	t.Parallel()
+
+	want := "John 3"
+	if got := fmt.Sprint("John ", 3); got != want {
+		t.Errorf("fmt.Sprint(%q, %d) = %v, want %v", "John ", 3, got, want)
+	}
+}
+
+func TestSprintf(t *testing.T) {
+	t.Parallel()
+
+	want := "John"
+	if got := fmt.Sprintf("%s", "John"); got != want {
+		t.Errorf("fmt.Sprintf(%q, %q) = %v, want %v", "%s", "John", got, want)
+	}
+}
+
+func countWords(words ...string) map[string]int {
+	counts := make(map[string]int, len(words))
+	for _, word := range words {
+		counts[word]++
+	}
+
+	return counts
+}
+
+func TestCountWordsKeys(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"a", "b"}
+	counts := countWords("a", "b")
+	var got []string
+	for word := range counts { // want `The order of got depends on the map iteration order, sort it before comparing it, .*`
+		got = append(got, word)
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("countWords(%q, %q) words = %v, want %v", "a", "b", got, want)
+	}
+}
+
+func TestCountWordsSortedKeys(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"a", "b"}
+	counts := countWords("a", "b")
+	var got []string
+	for word := range counts {
+		got = append(got, word)
+	}
+	slices.Sort(got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("countWords(%q, %q) words mismatch (-want +got):\n%s", "a", "b", diff)
+	}
+}
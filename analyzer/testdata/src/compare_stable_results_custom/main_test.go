@@ -0,0 +1,30 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func encode(s string) string {
+	return s
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	want := "a"
+	got := encode("a")
+	if got != want { // want `The output of main.encode is not stable, .*`
+		t.Errorf("encode(%v) = %v, want %v", "a", got, want)
+	}
+}
+
+func TestJSONNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	want := `"a"`
+	got, _ := json.Marshal("a")
+	if string(got) != want {
+		t.Errorf("json.Marshal(%v) = %v, want %v", "a", got, want)
+	}
+}
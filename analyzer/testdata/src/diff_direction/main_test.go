@@ -0,0 +1,164 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type user struct {
+	name, surname string
+}
+
+func newUser(name string) user {
+	return user{name: name}
+}
+
+func TestNewUserWantGot(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-want +got):\n%s", "John", diff)
+	}
+}
+
+func TestNewUserGotWant(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-got +want):\n%s", "John", diff)
+	}
+}
+
+func TestNewUserExpectedActual(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-expected +actual):\n%s", "John", diff)
+	}
+}
+
+func TestNewUserWrongLegend(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-got +want):\n%s", "John", diff) // want `The diff legend \(-got \+want\) does not match the order of the cmp.Diff arguments, it should be \(-want \+got\)`
+	}
+}
+
+func TestNewUserWrongLegendWithComma(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-want, +got):\n%s", "John", diff) // want `The diff legend \(-want, \+got\) does not match the order of the cmp.Diff arguments, it should be \(-got \+want\)`
+	}
+}
+
+func TestNewUserWrongLegendWithOptions(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(user{})); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-got +want):\n%s", "John", diff) // want `The diff legend \(-got \+want\) does not match the order of the cmp.Diff arguments, it should be \(-want \+got\)`
+	}
+}
+
+func TestNewUserLegendAtTheStart(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want +got) newUser(%q) mismatch:\n%s", "John", diff)
+	}
+}
+
+func TestNewUserWrongLegendNotBeforeDiff(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-got +want) newUser(%q) mismatch:\n%s", "John", diff) // want `The diff legend \(-got \+want\) does not match the order of the cmp.Diff arguments, it should be \(-want \+got\)`
+	}
+}
+
+func TestNewUserMissingLegend(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch:\n%s", "John", diff) // want `The failure message should explain the direction of the diff with the \(-want \+got\) legend`
+	}
+}
+
+func TestNewUserMissingLegendRawString(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf(`newUser(%q) mismatch: %s`, "John", diff) // want `The failure message should explain the direction of the diff with the \(-got \+want\) legend`
+	}
+}
+
+func TestNewUserOnlyDiff(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("%s", diff) // want `The failure message should explain the direction of the diff with the \(-want \+got\) legend`
+	}
+}
+
+func TestNewUserNotFormatted(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("newUser mismatch", diff) // want `The failure message should explain the direction of the diff with the \(-want \+got\) legend`
+	}
+}
+
+func TestNewUserDiffNotPrinted(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch (-want +got)", "John") // want `The diff returned by cmp.Diff should be printed in the failure message`
+	}
+}
+
+func TestNewUserDiffNotPrintedWithoutLegend(t *testing.T) {
+	t.Parallel()
+
+	want := user{name: "John"}
+	got := newUser("John")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch", "John") // want `The diff returned by cmp.Diff should be printed in the failure message`
+	}
+}
+
+func TestNewUserUnknownDirection(t *testing.T) {
+	t.Parallel()
+
+	got := newUser("John")
+	if diff := cmp.Diff(got, got); diff != "" {
+		t.Errorf("newUser(%q) mismatch:\n%s", "John", diff)
+	}
+}
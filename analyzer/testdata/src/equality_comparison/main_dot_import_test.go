@@ -0,0 +1,16 @@
+package main
+
+import (
+	. "reflect"
+	"testing"
+)
+
+func TestDotImportNewMyStruct(t *testing.T) {
+	t.Parallel()
+
+	want := MyStruct{id: 0, name: "John"}
+	got := NewMyStruct(want.id, want.name)
+	if !DeepEqual(got, want) { // want `Use cmp.Equal or cmp.Diff for equality comparison`
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
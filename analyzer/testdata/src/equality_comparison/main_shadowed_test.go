@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+)
+
+type comparator struct{}
+
+func (comparator) DeepEqual(x, y any) bool {
+	return x == y
+}
+
+func TestShadowedReflectNewMyStruct(t *testing.T) {
+	t.Parallel()
+
+	reflect := comparator{}
+
+	want := MyStruct{id: 0, name: "John"}
+	got := NewMyStruct(want.id, want.name)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
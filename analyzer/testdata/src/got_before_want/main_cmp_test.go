@@ -41,3 +41,53 @@ func TestTableDrivenDouble(t *testing.T) {
 		})
 	}
 }
+
+func TestDoublePrefixedMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("double(1): want %d, got %d", want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleRawStringMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf(`double(1) Expected: %d, but got: %d`, want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleUnlabelledMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("double(1): %d != %d", want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleFatalf(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Fatalf("want %d, got %d", want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleError(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Error("want", want, "got", got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
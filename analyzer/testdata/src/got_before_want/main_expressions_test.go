@@ -0,0 +1,105 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func doubleAll(in []int) []int {
+	out := make([]int, 0, len(in))
+	for _, i := range in {
+		out = append(out, double(i))
+	}
+
+	return out
+}
+
+func doublePtr(a int) *int {
+	d := double(a)
+
+	return &d
+}
+
+func TestDoubleAllLen(t *testing.T) {
+	t.Parallel()
+
+	want := []int{2, 4}
+	got := doubleAll([]int{1, 2})
+	if !cmp.Equal(got, want) {
+		t.Errorf("want %v, got %v", len(want), len(got)) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleAllIndex(t *testing.T) {
+	t.Parallel()
+
+	want := []int{2, 4}
+	got := doubleAll([]int{1, 2})
+	if !cmp.Equal(got, want) {
+		t.Errorf("expected: %v, actual: %v", want[0], got[0]) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoublePtr(t *testing.T) {
+	t.Parallel()
+
+	want := doublePtr(1)
+	got := doublePtr(1)
+	if !cmp.Equal(got, want) {
+		t.Errorf("want %v, got %v", (*want), *got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleString(t *testing.T) {
+	t.Parallel()
+
+	want := "2"
+	got := strconv.Itoa(double(1))
+	if got != want {
+		t.Errorf("strconv.Itoa(double(%v)) = %v, want %v", []int{1}[0], []byte(got), len(want))
+	}
+}
+
+func TestTableDrivenDoubleAll(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   []int
+		want []int
+	}{
+		"one": {
+			in:   []int{1},
+			want: []int{2},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := doubleAll(tc.in)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("doubleAll(%v) = %v, want %v", tc.in[0], len(got), len(tc.want))
+			}
+		})
+	}
+}
+
+func TestItoaWantLiteral(t *testing.T) {
+	t.Parallel()
+
+	got := strconv.Itoa(3)
+	if got != "3" {
+		t.Errorf("strconv.Itoa(%d) = %q, want %q", 3, got, "3")
+	}
+}
+
+func TestItoaWantLiteralPrintedFirst(t *testing.T) {
+	t.Parallel()
+
+	got := strconv.Itoa(3)
+	if got != "3" {
+		t.Errorf("strconv.Itoa(%d): want %q, got %q", 3, "3", got)
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestDoubleIfInit(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	if got := double(1); got != want {
+		t.Errorf("want %v, got %v", want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDoubleIfInitGotBeforeWant(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	if got := double(1); want != got {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"helper_facts/testutil"
+)
+
+func assertEqual(t *testing.T, got, want int) { // want assertEqual:`helper\(got=1, want=2, message=-1, callsHelper=false\)` `Test helpers that report failures should call t.Helper\(\) as their first statement`
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDoubleGotBeforeWant(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	testutil.AssertEqual(t, got, want)
+}
+
+func TestDoubleWantBeforeGot(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	testutil.AssertEqual(t, want, got) // want `The actual value that the function returned should be passed as the got parameter of the helper`
+}
+
+func TestDoubleLocalHelperWantBeforeGot(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	assertEqual(t, want, got) // want `The actual value that the function returned should be passed as the got parameter of the helper`
+}
+
+func TestDoubleDiffMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	testutil.AssertEqualf(t, want, got, "double(%v)", 1) // want `Test helper testutil.AssertEqualf does not call t.Helper\(\), so its failures are reported in the helper instead of in this line`
+}
+
+func TestDoubleDiffWithoutFunctionName(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	testutil.AssertEqualf(t, got, want, "unexpected result") // want `Test helper testutil.AssertEqualf does not call t.Helper\(\), .*` `The actual value that the function returned should be passed as the got parameter of the helper` `Failure messages should include the name of the function that failed`
+}
+
+func TestDoubleMsgAndArgs(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	testutil.AssertDiff(t, want, got) // want `Failure messages should include the name of the function that failed`
+	testutil.AssertDiff(t, want, got, "double(1)")
+}
+
+func TestDoubleNotAComparison(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	testutil.Log(t, want, got)
+}
@@ -0,0 +1,37 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// AssertEqual reports a failure if got and want are not equal.
+func AssertEqual(t testing.TB, got, want any) {
+	t.Helper()
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// AssertEqualf reports a failure, with the failure message, if got and want are not equal.
+func AssertEqualf(t testing.TB, expected, actual any, format string, args ...any) {
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf(format+": mismatch (-want +got):\n%s", append(args, diff)...)
+	}
+}
+
+// AssertDiff reports a failure if there is a diff between a and b.
+func AssertDiff(t *testing.T, a, b any, msgAndArgs ...any) {
+	t.Helper()
+
+	if diff := cmp.Diff(a, b); diff != "" {
+		t.Fatal(append(msgAndArgs, diff)...)
+	}
+}
+
+// Log logs the values, it's not a comparison.
+func Log(t testing.TB, got, want any) {
+	t.Logf("got %v, want %v", got, want)
+}
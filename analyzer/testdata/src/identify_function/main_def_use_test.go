@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func greet(name string) (string, error) {
+	return "Hello " + name + " ", nil
+}
+
+func divMod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func TestGreetNormalized(t *testing.T) {
+	t.Parallel()
+
+	want := "Hello World"
+	got, err := greet("World")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got = strings.TrimSpace(got)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestDivModSeveralOutputs(t *testing.T) {
+	t.Parallel()
+
+	wantDiv, wantMod := 2, 1
+	gotDiv, gotMod := divMod(5, 2)
+	if gotDiv != wantDiv {
+		t.Errorf("got %v, want %v", gotDiv, wantDiv) // want `Failure messages should include the name of the function that failed`
+	}
+	if gotMod != wantMod {
+		t.Errorf("got %v, want %v", gotMod, wantMod) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestDoubleCallFarBack(t *testing.T) {
+	t.Parallel()
+
+	got := double(1)
+	t.Logf("double returned %v", got)
+	want := 2
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func makeDivWant(a, b int) int {
+	return a / b
+}
+
+func TestDivModWantFromCall(t *testing.T) {
+	t.Parallel()
+
+	a, b := 5, 2
+	got, _ := divMod(a, b)
+	want := makeDivWant(a, b)
+	if got != want {
+		t.Errorf("divMod(%v, %v) = %v, want %v", a, b, got, want)
+	}
+}
+
+func TestDivModReassignedInIf(t *testing.T) {
+	t.Parallel()
+
+	want := makeDivWant(4, 2)
+	got := 0
+	if want > 0 {
+		got, _ = divMod(4, 2)
+	}
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+)
+
+func doubleAll(in []int) []int {
+	out := make([]int, 0, len(in))
+	for _, i := range in {
+		out = append(out, double(i))
+	}
+
+	return out
+}
+
+func TestTableDrivenDoubleAllExpressions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   []int
+		want []int
+	}{
+		"one": {
+			in:   []int{1},
+			want: []int{2},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := doubleAll(tc.in)
+			if len(got) != len(tc.want) {
+				t.Errorf("doubleAll(%v) = %v, want %v", tc.in[0], len(got), len(tc.want))
+			}
+		})
+	}
+}
+
+func TestDoubleExpressions(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1}
+	want := 2
+	got := double(in[0])
+	if got != want {
+		t.Errorf("got %v, want %v", got, (want)) // want `Failure messages should include the name of the function that failed`
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDoubleIfInit(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	if got := double(1); got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestDoubleIfInitValidMessage(t *testing.T) {
+	t.Parallel()
+
+	in := 1
+	want := 2
+	if got := double(in); got != want {
+		t.Errorf("double(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func TestDoubleIfInitCmpEqual(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	if got := double(1); !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestSumAndBoolIfInit(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	if got, _ := sumAndBool(1, 1); got != want {
+		t.Fatalf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestTableDrivenDoubleIfInit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"one": {
+			in:   1,
+			want: 2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := double(tc.in); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want) // want `Failure messages should include the name of the function that failed` `Failure messages should include the inputs of the function that failed`
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestDoubleLogContextBeforeErrorf(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Logf("the input was %v", 1)
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestDoubleFatalfAndReturn(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Fatalf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+		return
+	}
+}
+
+func TestDoubleSeveralReporters(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+		t.Error("got", got, "want", want)      // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestDoubleSeveralLogsAndFailNow(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Log("double failed")
+		t.Logf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+		t.FailNow()
+	}
+}
+
+func TestDoubleValidMessageAndReturn(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Logf("the input was %v", 1)
+		t.Errorf("double(%v) = %v, want %v", 1, got, want)
+		return
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestFatalf(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Fatalf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Error("got", got, "want", want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestErrorValidMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Error("double(1) =", got, "want", want)
+	}
+}
+
+func TestFatal(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Fatal(got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestLogfFail(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Logf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+		t.Fail()
+	}
+}
+
+func TestLogFailNowValidMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Log("double(1) =", got, "want", want)
+		t.FailNow()
+	}
+}
+
+func TestLogfWithoutFail(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Logf("got %v, want %v", got, want)
+	}
+}
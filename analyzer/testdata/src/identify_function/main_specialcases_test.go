@@ -60,3 +60,50 @@ func TestGetDurationValidMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestRawStringMessage(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf(`got: %v, want: %v`, got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestNegativeLiteralInput(t *testing.T) {
+	t.Parallel()
+
+	want := -2
+	got := double(-1)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestCallInput(t *testing.T) {
+	t.Parallel()
+
+	counter := 0
+	next := func() int {
+		counter++
+
+		return counter
+	}
+
+	want := 2
+	got := double(next())
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestDoubleExplicitArgumentIndexes(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("got %[1]v, want %[2]v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+
+	. "github.com/google/go-cmp/cmp"
+)
+
+type T = testing.T
+
+type equaler struct{}
+
+func (equaler) Equal(x, y any) bool {
+	return x == y
+}
+
+func TestDotImportCmpEqual(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if !Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
+
+func TestLocalVariableNamedCmp(t *testing.T) {
+	t.Parallel()
+
+	cmp := equaler{}
+
+	want := 2
+	got := double(1)
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTestingTAlias(t *T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the name of the function that failed`
+	}
+}
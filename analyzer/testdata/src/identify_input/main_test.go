@@ -0,0 +1,195 @@
+package main
+
+import (
+	"testing"
+)
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
+
+func sum(a, b int) int {
+	return a + b
+}
+
+func TestAbsLiteralInput(t *testing.T) {
+	t.Parallel()
+
+	want := 1
+	got := abs(-1)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAbsInputNotPrinted(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	got := abs(in)
+	if got != want {
+		t.Errorf("abs() = %v, want %v", got, want) // want `Failure messages should include the inputs of the function that failed`
+	}
+}
+
+func TestAbsNoFunctionName(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	got := abs(in)
+	if got != want {
+		t.Errorf("got %v, want %v", got, want) // want `Failure messages should include the inputs of the function that failed`
+	}
+}
+
+func TestAbsInputPrinted(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	got := abs(in)
+	if got != want {
+		t.Errorf("abs(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func TestAbsExplicitArgumentIndex(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	got := abs(in)
+	if got != want {
+		t.Errorf("abs(%[3]v) = %[1]v, want %[2]v", got, want, in)
+	}
+}
+
+func TestSumMissingOneInput(t *testing.T) {
+	t.Parallel()
+
+	a, b := 1, 2
+	want := 3
+	got := sum(a, b)
+	if got != want {
+		t.Errorf("sum(%v) = %v, want %v", a, got, want) // want `Failure messages should include the inputs of the function that failed`
+	}
+}
+
+func TestSumInputOutsideParenthesis(t *testing.T) {
+	t.Parallel()
+
+	a, b := 1, 2
+	want := 3
+	got := sum(a, b)
+	if got != want {
+		t.Errorf("sum(%v) = %v, want %v, b=%v", a, got, want, b) // want `Failure messages should include the inputs of the function that failed`
+	}
+}
+
+func TestTableDrivenAbs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"negative": {
+			in:   -1,
+			want: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := abs(tc.in)
+			if got != tc.want {
+				t.Errorf("abs(%d) = %d, want %d", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableDrivenAbsInputNotPrinted(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"negative": {
+			in:   -1,
+			want: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := abs(tc.in)
+			if got != tc.want {
+				t.Errorf("abs() = %d, want %d", got, tc.want) // want `Failure messages should include the inputs of the function that failed`
+			}
+		})
+	}
+}
+
+func TestAbsErrorInputPrinted(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	got := abs(in)
+	if got != want {
+		t.Error("abs", in, "=", got, "want", want)
+	}
+}
+
+func TestAbsFatalInputNotPrinted(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	got := abs(in)
+	if got != want {
+		t.Fatal("abs", got, want) // want `Failure messages should include the inputs of the function that failed`
+	}
+}
+
+func TestAbsIfInitInputNotPrinted(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	if got := abs(in); got != want {
+		t.Errorf("abs() = %v, want %v", got, want) // want `Failure messages should include the inputs of the function that failed`
+	}
+}
+
+func TestAbsIfInitInputPrinted(t *testing.T) {
+	t.Parallel()
+
+	in := -1
+	want := 1
+	if got := abs(in); got != want {
+		t.Errorf("abs(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func TestAbsWithoutFunctionName(t *testing.T) {
+	t.Parallel()
+
+	fns := []func(int) int{abs}
+	in := -1
+	want := 1
+	got := fns[0](in)
+	if got != want {
+		t.Errorf("(first) got %v, want %v for input %v", got, want, in)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+type user struct {
+	name string
+}
+
+func double(a int) int {
+	return 2 * a
+}
+
+func newUser(name string) (*user, error) {
+	return &user{name: name}, nil
+}
+
+func TestDoubleFatalf(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Fatalf("double(%v) = %v, want %v", 1, got, want) // want `Prefer t.Error over t.Fatal so the test keeps going and reports all the failures`
+	}
+
+	want = 4
+	got = double(2)
+	if got != want {
+		t.Fatal("double", 2, got, want) // want `Prefer t.Error over t.Fatal so the test keeps going and reports all the failures`
+	}
+}
+
+func TestDoubleLogfFailNow(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Logf("double(%v) = %v, want %v", 1, got, want) // want `Prefer t.Error over t.Fatal so the test keeps going and reports all the failures`
+		t.FailNow()
+	}
+}
+
+func TestDoubleErrorf(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got := double(1)
+	if got != want {
+		t.Errorf("double(%v) = %v, want %v", 1, got, want)
+	}
+}
+
+func TestAtoiLaterStatementsDependOnValue(t *testing.T) {
+	t.Parallel()
+
+	want := 2
+	got, err := strconv.Atoi("2")
+	if err != nil {
+		t.Fatalf("strconv.Atoi(%q) returned unexpected error: %v", "2", err)
+	}
+	if got != want {
+		t.Fatalf("strconv.Atoi(%q) = %v, want %v", "2", got, want)
+	}
+
+	if got*2 != 4 {
+		t.Errorf("unexpected double of %v", got)
+	}
+}
+
+func TestNewUserSetupFailure(t *testing.T) {
+	t.Parallel()
+
+	want := "John"
+	got, err := newUser("John")
+	if err != nil {
+		t.Fatalf("newUser(%q) returned unexpected error: %v", "John", err)
+	}
+	if got.name != want {
+		t.Errorf("newUser(%q).name = %v, want %v", "John", got.name, want)
+	}
+}
+
+func TestTableDrivenDouble(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"one": {
+			in:   1,
+			want: 2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(tc.in)
+			if got != tc.want {
+				t.Fatalf("double(%v) = %v, want %v", tc.in, got, tc.want) // want `Prefer t.Error over t.Fatal so the test keeps going and reports all the failures`
+			}
+		})
+	}
+}
+
+func TestTableDrivenAtoi(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   string
+		want int
+	}{
+		"two": {
+			in:   "2",
+			want: 2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := strconv.Atoi(tc.in)
+			if err != nil {
+				t.Fatalf("strconv.Atoi(%q) returned unexpected error: %v", tc.in, err)
+			}
+			if got != tc.want {
+				t.Fatalf("strconv.Atoi(%q) = %v, want %v", tc.in, got, tc.want) // want `Prefer t.Error and return over t.Fatal inside the t.Run body, returning from the subtest is enough`
+			}
+
+			if got*2 != 2*tc.want {
+				t.Errorf("unexpected double of %v", got)
+			}
+		})
+	}
+}
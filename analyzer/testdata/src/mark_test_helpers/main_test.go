@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+)
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
+
+func assertAbs(t *testing.T, in, want int) { // want `Test helpers that report failures should call t.Helper\(\) as their first statement`
+	if got := abs(in); got != want {
+		t.Errorf("abs(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func assertAbsMarked(t *testing.T, in, want int) {
+	t.Helper()
+
+	if got := abs(in); got != want {
+		t.Errorf("abs(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func assertAbsHelperNotFirst(tb testing.TB, in, want int) { // want `Test helpers that report failures should call t.Helper\(\) as their first statement`
+	got := abs(in)
+	tb.Helper()
+
+	if got != want {
+		tb.Fatalf("abs(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func logAbs(t *testing.T, in int) {
+	t.Logf("abs(%v) = %v", in, abs(in))
+}
+
+func benchmarkAbs(b *testing.B, in int) { // want `Test helpers that report failures should call t.Helper\(\) as their first statement`
+	for b.Loop() {
+		if abs(in) < 0 {
+			b.Fatal("abs returned a negative number")
+		}
+	}
+}
+
+func TestAbs(t *testing.T) {
+	t.Parallel()
+
+	assertAbs(t, -1, 1)
+	assertAbsMarked(t, -1, 1)
+	assertAbsHelperNotFirst(t, -1, 1)
+	logAbs(t, -1)
+
+	check := func(t *testing.T, in, want int) { // want `Test helpers that report failures should call t.Helper\(\) as their first statement`
+		if got := abs(in); got != want {
+			t.Errorf("abs(%v) = %v, want %v", in, got, want)
+		}
+	}
+	check(t, -2, 2)
+
+	t.Run("subtest", func(t *testing.T) {
+		t.Parallel()
+
+		if got := abs(-3); got != 3 {
+			t.Errorf("abs(%v) = %v, want %v", -3, got, 3)
+		}
+	})
+}
+
+func BenchmarkAbs(b *testing.B) {
+	benchmarkAbs(b, -1)
+}
+
+func FuzzAbs(f *testing.F) {
+	f.Add(-1)
+	f.Fuzz(func(t *testing.T, in int) {
+		if abs(in) < 0 {
+			t.Errorf("abs(%v) returned a negative number", in)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type point struct {
+	x, y int
+}
+
+type id struct {
+	value int
+}
+
+func newPoint(x, y int) point {
+	return point{x: x, y: y}
+}
+
+func newPointPtr(x, y int) *point {
+	return &point{x: x, y: y}
+}
+
+func newID(value int) id {
+	return id{value: value}
+}
+
+func split(s string) []string {
+	return []string{s}
+}
+
+func counts(s string) map[string]int {
+	return map[string]int{s: 1}
+}
+
+func pair(a int) [2]int {
+	return [2]int{a, a}
+}
+
+func bytes(s string) []byte {
+	return []byte(s)
+}
+
+func TestNewPoint(t *testing.T) {
+	t.Parallel()
+
+	want := point{x: 1, y: 2}
+	got := newPoint(1, 2)
+	if got != want {
+		t.Errorf("newPoint(%v, %v) = %v, want %v", 1, 2, got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestNewPointPlusV(t *testing.T) {
+	t.Parallel()
+
+	want := &point{x: 1, y: 2}
+	got := newPointPtr(1, 2)
+	if *got != *want {
+		t.Errorf("newPointPtr(%v, %v) = %+v, want %+v", 1, 2, got, want)
+	}
+	if got != want {
+		t.Errorf("newPointPtr(%v, %v) = %+v, want %+v", 1, 2, got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestNewPointSharpV(t *testing.T) {
+	t.Parallel()
+
+	want := point{x: 1, y: 2}
+	got := newPoint(1, 2)
+	if got != want {
+		t.Errorf("newPoint(%v, %v) = %#v, want %#v", 1, 2, got, want)
+	}
+}
+
+func TestNewPointFields(t *testing.T) {
+	t.Parallel()
+
+	want := point{x: 1, y: 2}
+	got := newPoint(1, 2)
+	if got != want {
+		t.Errorf("newPoint(%v, %v).x = %v, want %v", 1, 2, got.x, want.x)
+	}
+}
+
+func TestNewIDSmallStruct(t *testing.T) {
+	t.Parallel()
+
+	want := id{value: 1}
+	got := newID(1)
+	if got != want {
+		t.Errorf("newID(%v) = %v, want %v", 1, got, want)
+	}
+}
+
+func TestSplitSlice(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"a"}
+	got := split("a")
+	if len(got) != len(want) {
+		t.Errorf("split(%q) = %v, want %v", "a", len(got), len(want))
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("split(%q) = %v, want %v", "a", got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestCountsMap(t *testing.T) {
+	t.Parallel()
+
+	want := map[string]int{"a": 1}
+	got := counts("a")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("counts(%q) = %v, want %v", "a", got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestPairArray(t *testing.T) {
+	t.Parallel()
+
+	want := [2]int{1, 1}
+	got := pair(1)
+	if got != want {
+		t.Errorf("pair(%v) = %v, want %v", 1, got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestBytes(t *testing.T) {
+	t.Parallel()
+
+	want := []byte("a")
+	got := bytes("a")
+	if !cmp.Equal(got, want) {
+		t.Errorf("bytes(%q) = %v, want %v", "a", got, want)
+	}
+}
+
+type Point struct {
+	X, Y int
+}
+
+func newExportedPoint(x, y int) Point {
+	return Point{X: x, Y: y}
+}
+
+func newExportedPointPtr(x, y int) *Point {
+	return &Point{X: x, Y: y}
+}
+
+func TestNewExportedPoint(t *testing.T) {
+	t.Parallel()
+
+	want := Point{X: 1, Y: 2}
+	got := newExportedPoint(1, 2)
+	if got != want {
+		t.Errorf("newExportedPoint(%v, %v) = %v, want %v", 1, 2, got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestNewExportedPointPtr(t *testing.T) {
+	t.Parallel()
+
+	want := &Point{X: 1, Y: 2}
+	got := newExportedPointPtr(1, 2)
+	if got != want {
+		t.Errorf("newExportedPointPtr(%v, %v) = %v, want %v", 1, 2, got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestNewExportedPointDiffDeclared(t *testing.T) {
+	t.Parallel()
+
+	diff := 0
+	want := Point{X: 1, Y: 2}
+	got := newExportedPoint(1, 2)
+	if got != want {
+		t.Errorf("newExportedPoint(%v, %v) = %v, want %v, diff %d", 1, 2, got, want, diff) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type point struct {
+	x, y int
+}
+
+type point3D struct {
+	x, y, z int
+}
+
+func newPoint(x, y int) point {
+	return point{x: x, y: y}
+}
+
+func newPoint3D(x, y, z int) point3D {
+	return point3D{x: x, y: y, z: z}
+}
+
+func split(s string) []string {
+	return []string{s}
+}
+
+func TestNewPointBelowMinFields(t *testing.T) {
+	t.Parallel()
+
+	want := point{x: 1, y: 2}
+	got := newPoint(1, 2)
+	if got != want {
+		t.Errorf("newPoint(%v, %v) = %v, want %v", 1, 2, got, want)
+	}
+}
+
+func TestNewPoint3D(t *testing.T) {
+	t.Parallel()
+
+	want := point3D{x: 1, y: 2, z: 3}
+	got := newPoint3D(1, 2, 3)
+	if got != want {
+		t.Errorf("newPoint3D(%v, %v, %v) = %v, want %v", 1, 2, 3, got, want) // want `Composite values should be printed as a diff, like in .*`
+	}
+}
+
+func TestSplitSliceNotReported(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"a"}
+	got := split("a")
+	if !cmp.Equal(got, want) {
+		t.Errorf("split(%q) = %v, want %v", "a", got, want)
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"testing"
+)
+
+const emptyName = ""
+
+func double(a int) int {
+	return 2 * a
+}
+
+func TestDoubleMapNames(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"two": {
+			in:   2,
+			want: 4,
+		},
+		"": { // want `Subtest names should not be empty`
+			in:   0,
+			want: 0,
+		},
+		"two/three": { // want `Subtest name "two/three" should not contain '/', since it separates the subtests in go test -run`
+			in:   3,
+			want: 6,
+		},
+		" four": { // want `Subtest name " four" should not have leading or trailing spaces`
+			in:   4,
+			want: 8,
+		},
+		"five\tsix": { // want `Subtest name "five\\tsix" is rewritten by go test as "five_six"`
+			in:   5,
+			want: 10,
+		},
+		"7": { // want `Subtest name "7" should be human-readable, not only a number`
+			in:   7,
+			want: 14,
+		},
+		"positive number": {
+			in:   8,
+			want: 16,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleSliceNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   int
+		want int
+	}{
+		{
+			name: "two",
+			in:   2,
+			want: 4,
+		},
+		{ // want `Subtest name "two" is duplicated`
+			name: "two",
+			in:   2,
+			want: 4,
+		},
+		{ // want `Subtest names should not be empty`
+			in:   0,
+			want: 0,
+		},
+		{ // want `Subtest names should not be empty`
+			name: emptyName,
+			in:   0,
+			want: 0,
+		},
+		{"three ", 3, 6}, // want `Subtest name "three " should not have leading or trailing spaces`
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleSlicePointerNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []*struct {
+		name string
+		in   int
+		want int
+	}{
+		{name: "1", in: 1, want: 2}, // want `Subtest name "1" should be human-readable, not only a number`
+		{name: "two", in: 2, want: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleRebindingNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   int
+		want int
+	}{
+		{name: "one", in: 1, want: 2},
+		{name: "one", in: 2, want: 4}, // want `Subtest name "one" is duplicated`
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+var sharedTests = map[string]struct {
+	in   int
+	want int
+}{
+	"1": {in: 1, want: 2}, // want `Subtest name "1" should be human-readable, not only a number`
+}
+
+func TestDoubleSharedNames(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range sharedTests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleSharedNamesAgain(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range sharedTests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleRewrittenDuplicatedNames(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"double two": {in: 2, want: 4},
+		"double_two": {in: 2, want: 4}, // want `Subtest name "double_two" is duplicated`
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+type namedCase struct {
+	name string
+}
+
+func TestDoublePromotedNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		namedCase
+		in   int
+		want int
+	}{
+		{namedCase: namedCase{name: "one"}, in: 1, want: 2},
+		{namedCase: namedCase{name: "two"}, in: 2, want: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
@@ -98,3 +98,26 @@ func TestSliceNonInlined(t *testing.T) {
 		})
 	}
 }
+
+func TestMapNonInlinedUsedTwice(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in  int
+		out int
+	}{
+		"test1": {
+			in:  1,
+			out: 1,
+		},
+	}
+	t.Logf("running %d tests", len(tests))
+	for name, test := range tests { // want `Expected map-inlined table driven test`
+		t.Run(name, func(t *testing.T) {
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
@@ -98,3 +98,136 @@ func TestSliceNonInlined(t *testing.T) {
 		})
 	}
 }
+
+func TestSliceNonInlinedRebinding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in int
+		out int
+	} {
+		{
+			name: "test1",
+			in: 1,
+			out: 1,
+		},
+	}
+	for _, test := range tests { // want `Expected map-non-inlined table driven test`
+		test := test
+		t.Logf("running %s", test.name)
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestSliceNonInlinedTwoRuns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in int
+		out int
+	} {
+		{
+			name: "test1",
+			in: 1,
+			out: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {})
+		t.Run(test.name+" again", func(t *testing.T) {})
+	}
+}
+
+func TestSliceNonInlinedDuplicatedNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in int
+		out int
+	} {
+		{
+			name: "positive",
+			in: 1,
+			out: 1,
+		},
+		{ // want `Subtest name "positive" is duplicated`
+			name: "positive",
+			in: 2,
+			out: 2,
+		},
+	}
+	for _, test := range tests { // want `Expected map-non-inlined table driven test`
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestSliceNonInlinedNonConstantNames(t *testing.T) {
+	t.Parallel()
+
+	name := "positive"
+	tests := []struct {
+		name string
+		in int
+		out int
+	} {
+		{
+			name: name,
+			in: 1,
+			out: 1,
+		},
+	}
+	for _, test := range tests { // want `Expected map-non-inlined table driven test`
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestSliceNonInlinedTableIndexed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in int
+		out int
+	} {
+		{
+			name: "positive",
+			in: 1,
+			out: 1,
+		},
+	}
+	t.Logf("first input %d", tests[0].in)
+	for _, test := range tests { // want `Expected map-non-inlined table driven test`
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package shared
+
+import "testing"
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+var sliceTests = []struct {
+	name string
+	in   int
+	out  int
+}{
+	{
+		name: "test1",
+		in:   1,
+		out:  1,
+	},
+}
+
+var mapTests = map[string]struct {
+	in  int
+	out int
+}{
+	"test1": {
+		in:  1,
+		out: 1,
+	},
+}
+
+func mapCases() map[string]struct {
+	in  int
+	out int
+} {
+	return map[string]struct {
+		in  int
+		out int
+	}{
+		"test1": {
+			in:  1,
+			out: 1,
+		},
+	}
+}
+
+func sliceCases() []struct {
+	name string
+	in   int
+	out  int
+} {
+	tests := []struct {
+		name string
+		in   int
+		out  int
+	}{
+		{
+			name: "test1",
+			in:   1,
+			out:  1,
+		},
+	}
+
+	return tests
+}
+
+func TestPackageSlice(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range sliceTests { // want `Expected map table driven test`
+		t.Run(test.name, func(t *testing.T) {
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestPackageSliceAgain(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range sliceTests { // want `Expected map table driven test`
+		t.Run(test.name, func(t *testing.T) {
+			got := abs(-test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", -test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestPackageMap(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range mapTests {
+		t.Run(name, func(t *testing.T) {
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range mapCases() {
+		t.Run(name, func(t *testing.T) {
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
+
+func TestFuncSlice(t *testing.T) {
+	t.Parallel()
+
+	tests := sliceCases()
+	for _, test := range tests { // want `Expected map table driven test`
+		t.Run(test.name, func(t *testing.T) {
+			got := abs(test.in)
+			if got != test.out {
+				t.Errorf("abs(%d) = %d, want %d", test.in, got, test.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package main
+
+func double(a int) int {
+	return a * 2
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+)
+
+func BenchmarkDouble(b *testing.B) {
+	want := 2
+	got := double(1)
+	if got != want {
+		b.Fatalf("double(1) = %v, want %v", got, want)
+	}
+
+	for range b.N {
+		got = double(1)
+	}
+
+	if got != want {
+		b.Errorf("double(1): want %v, got %v", want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func FuzzDouble(f *testing.F) {
+	f.Add(1)
+	f.Fuzz(func(t *testing.T, in int) {
+		want := in * 2
+		got := double(in)
+		if got != want {
+			t.Fatalf("double(%v) = %v, want %v", in, want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected` `Prefer t.Error over t.Fatal so the test keeps going and reports all the failures`
+		}
+	})
+}
+
+func checkDouble(tb testing.TB, in, want int) {
+	tb.Helper()
+
+	got := double(in)
+	if got != want {
+		tb.Errorf("double(%v): want %v, got %v", in, want, got) // want `Test outputs should output the actual value that the function returned before printing the value that was expected`
+	}
+}
+
+func TestDouble(t *testing.T) {
+	t.Parallel()
+
+	checkDouble(t, 1, 2)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestDoubleSlice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   int
+		want int
+	}{
+		{in: 1, want: 2},
+		{in: 2, want: 4},
+	}
+	for _, test := range tests { // want `Run each test case in a subtest with t.Run, so the test cases can be run on their own and keep going after a fatal failure`
+		got := double(test.in)
+		if got != test.want {
+			t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestDoubleMapInlined(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range map[string]struct { // want `Run each test case in a subtest with t.Run, so the test cases can be run on their own and keep going after a fatal failure`
+		in   int
+		want int
+	}{
+		"one": {in: 1, want: 2},
+	} {
+		got := double(test.in)
+		if got != test.want {
+			t.Fatalf("%s: double(%v) = %v, want %v", name, test.in, got, test.want) // want `Prefer t.Error over t.Fatal so the test keeps going and reports all the failures`
+		}
+	}
+}
+
+func TestDoubleSubtests(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in   int
+		want int
+	}{
+		"one": {in: 1, want: 2},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := double(test.in)
+			if got != test.want {
+				t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleNoFailures(t *testing.T) {
+	t.Parallel()
+
+	ins := []int{1, 2}
+	for _, in := range ins {
+		t.Logf("double(%v) = %v", in, double(in))
+	}
+}
+
+func TestDoubleSimpleLoop(t *testing.T) {
+	t.Parallel()
+
+	got := double(3)
+	if got != 6 {
+		t.Errorf("wrong %v %v", 6, got) // want `Failure messages should include the name of the function that failed`
+	}
+
+	for _, in := range []int{1, 2} {
+		if double(in) != in+in {
+			t.Errorf("double(%v) = %v, want %v", in, double(in), in+in)
+		}
+	}
+}
+
+func TestDoubleComparisonAfterLoop(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   int
+		want int
+	}{
+		{in: 1, want: 2},
+	}
+	for _, test := range tests { // want `Run each test case in a subtest with t.Run, so the test cases can be run on their own and keep going after a fatal failure`
+		got := double(test.in)
+		if got != test.want {
+			t.Errorf("double(%v) = %v, want %v", test.in, got, test.want)
+		}
+	}
+
+	got := double(3)
+	if got != 6 {
+		t.Errorf("wrong %v %v", 6, got) // want `Failure messages should include the name of the function that failed`
+	}
+}